@@ -8,7 +8,7 @@ import (
 
 func TestAccAWSBillingServiceAccount_basic(t *testing.T) {
 	resource.Test(t, resource.TestCase{
-		PreCheck:  func() { testAccPreCheck(t) },
+		PreCheck:  func() { testAccPreCheck(t); testAccPartitionPreCheck(t, "aws") },
 		Providers: testAccProviders,
 		Steps: []resource.TestStep{
 			{
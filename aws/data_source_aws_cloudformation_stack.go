@@ -19,12 +19,9 @@ func dataSourceAwsCloudFormationStack() *schema.Resource {
 				Required: true,
 			},
 			"template_body": {
-				Type:     schema.TypeString,
-				Computed: true,
-				StateFunc: func(v interface{}) string {
-					template, _ := normalizeCloudFormationTemplate(v)
-					return template
-				},
+				Type:      schema.TypeString,
+				Computed:  true,
+				StateFunc: normalizeCloudFormationTemplateStateFunc,
 			},
 			"capabilities": {
 				Type:     schema.TypeSet,
@@ -0,0 +1,43 @@
+package aws
+
+import (
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const (
+	// See https://docs.aws.amazon.com/AmazonCloudFront/latest/DeveloperGuide/AccessLogs.html#AccessLogsBucketAndFileOwnership
+	cloudFrontLogDeliveryDefaultCanonicalUserId = "c4c1ede66af53448b93c283ce9448c4ba468c9432aa01d700d3878632f77d2d0"
+
+	// See https://docs.amazonaws.cn/en_us/AmazonCloudFront/latest/DeveloperGuide/AccessLogs.html#AccessLogsBucketAndFileOwnership
+	cloudFrontLogDeliveryCnCanonicalUserId = "a52cb28745c0c06e84ec548334e44bfa7fc2a85c54af20cd59e4969344b7af56"
+)
+
+func dataSourceAwsCloudFrontLogDeliveryCanonicalUserId() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsCloudFrontLogDeliveryCanonicalUserIdRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsCloudFrontLogDeliveryCanonicalUserIdRead(d *schema.ResourceData, meta interface{}) error {
+	region := meta.(*AWSClient).region
+	if v, ok := d.GetOk("region"); ok {
+		region = v.(string)
+	}
+
+	canonicalId := cloudFrontLogDeliveryDefaultCanonicalUserId
+	if p, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region); ok && p.ID() == endpoints.AwsCnPartitionID {
+		canonicalId = cloudFrontLogDeliveryCnCanonicalUserId
+	}
+
+	d.SetId(canonicalId)
+
+	return nil
+}
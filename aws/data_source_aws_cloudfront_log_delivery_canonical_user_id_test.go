@@ -0,0 +1,38 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSCloudFrontLogDeliveryCanonicalUserId_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAwsCloudFrontLogDeliveryCanonicalUserIdConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_cloudfront_log_delivery_canonical_user_id.main", "id", "c4c1ede66af53448b93c283ce9448c4ba468c9432aa01d700d3878632f77d2d0"),
+				),
+			},
+			{
+				Config: testAccCheckAwsCloudFrontLogDeliveryCanonicalUserIdCnRegionConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_cloudfront_log_delivery_canonical_user_id.cn", "id", "a52cb28745c0c06e84ec548334e44bfa7fc2a85c54af20cd59e4969344b7af56"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckAwsCloudFrontLogDeliveryCanonicalUserIdConfig = `
+data "aws_cloudfront_log_delivery_canonical_user_id" "main" { }
+`
+
+const testAccCheckAwsCloudFrontLogDeliveryCanonicalUserIdCnRegionConfig = `
+data "aws_cloudfront_log_delivery_canonical_user_id" "cn" {
+	region = "cn-northwest-1"
+}
+`
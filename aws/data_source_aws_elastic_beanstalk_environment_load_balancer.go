@@ -0,0 +1,95 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsElasticBeanstalkEnvironmentLoadBalancer() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsElasticBeanstalkEnvironmentLoadBalancerRead,
+
+		Schema: map[string]*schema.Schema{
+			"environment_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			// Computed values.
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"listener_arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsElasticBeanstalkEnvironmentLoadBalancerRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).elasticbeanstalkconn
+	envId := d.Get("environment_id").(string)
+
+	log.Printf("[DEBUG] Reading Elastic Beanstalk Environment (%s) resources", envId)
+	resources, err := conn.DescribeEnvironmentResources(&elasticbeanstalk.DescribeEnvironmentResourcesInput{
+		EnvironmentId: aws.String(envId),
+	})
+	if err != nil {
+		return fmt.Errorf("Error reading Elastic Beanstalk Environment (%s) resources: %s", envId, err)
+	}
+
+	lbs := resources.EnvironmentResources.LoadBalancers
+	if len(lbs) == 0 {
+		return fmt.Errorf("Elastic Beanstalk Environment (%s) has no load balancer", envId)
+	}
+
+	lbArn, lbType := elasticBeanstalkEnvironmentLoadBalancer(meta.(*AWSClient), lbs)
+	lbName := aws.StringValue(lbs[0].Name)
+
+	// Only application and network load balancers have listeners with ARNs.
+	listenerArns := make([]string, 0)
+	if lbType != elasticBeanstalkLoadBalancerTypeClassic {
+		// loadbalancer/app/<name>/<id>
+		if parts := strings.Split(lbArn, "/"); len(parts) == 4 {
+			lbName = parts[2]
+		}
+
+		err := meta.(*AWSClient).elbv2conn.DescribeListenersPages(&elbv2.DescribeListenersInput{
+			LoadBalancerArn: aws.String(lbArn),
+		}, func(page *elbv2.DescribeListenersOutput, lastPage bool) bool {
+			for _, listener := range page.Listeners {
+				listenerArns = append(listenerArns, aws.StringValue(listener.ListenerArn))
+			}
+			return !lastPage
+		})
+		if err != nil {
+			return fmt.Errorf("Error reading listeners of Load Balancer (%s): %s", lbArn, err)
+		}
+	}
+
+	d.SetId(lbArn)
+	d.Set("arn", lbArn)
+	d.Set("type", lbType)
+	d.Set("name", lbName)
+	if err := d.Set("listener_arns", listenerArns); err != nil {
+		return fmt.Errorf("Error setting listener_arns: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSElasticBeanstalkEnvironmentLoadBalancerDataSource_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	dataSourceName := "data.aws_elastic_beanstalk_environment_load_balancer.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccElasticBeanstalkPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBeanstalkEnvDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSElasticBeanstalkEnvironmentLoadBalancerDataSourceConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", "aws_elastic_beanstalk_environment.tfenvtest", "load_balancer_arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "type", "application"),
+					resource.TestMatchResourceAttr(dataSourceName, "name", regexp.MustCompile("^awseb-")),
+					resource.TestCheckResourceAttr(dataSourceName, "listener_arns.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSElasticBeanstalkEnvironmentLoadBalancerDataSourceConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource "aws_elastic_beanstalk_application" "tftest" {
+  name        = "tf-test-name-%d"
+  description = "tf-test-desc"
+}
+
+resource "aws_elastic_beanstalk_environment" "tfenvtest" {
+  name                = "tf-test-name-%d"
+  application         = "${aws_elastic_beanstalk_application.tftest.name}"
+  solution_stack_name = "64bit Amazon Linux running Python"
+
+  setting {
+    namespace = "aws:elasticbeanstalk:environment"
+    name      = "LoadBalancerType"
+    value     = "application"
+  }
+}
+
+data "aws_elastic_beanstalk_environment_load_balancer" "test" {
+  environment_id = "${aws_elastic_beanstalk_environment.tfenvtest.id}"
+}
+`, rInt, rInt)
+}
@@ -10,18 +10,21 @@ import (
 var elbAccountIdPerRegionMap = map[string]string{
 	"ap-northeast-1": "582318560864",
 	"ap-northeast-2": "600734575887",
+	"ap-northeast-3": "383597477331",
 	"ap-south-1":     "718504428378",
 	"ap-southeast-1": "114774131450",
 	"ap-southeast-2": "783225319266",
 	"ca-central-1":   "985666609251",
 	"cn-north-1":     "638102146993",
+	"cn-northwest-1": "037604701340",
 	"eu-central-1":   "054676820928",
 	"eu-west-1":      "156460612806",
 	"eu-west-2":      "652711504416",
+	"eu-west-3":      "009996457667",
 	"sa-east-1":      "507241528517",
 	"us-east-1":      "127311923021",
 	"us-east-2":      "033677994240",
-	"us-gov-west":    "048591011584",
+	"us-gov-west-1":  "048591011584",
 	"us-west-1":      "027434742980",
 	"us-west-2":      "797873946194",
 }
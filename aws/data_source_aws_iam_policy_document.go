@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"bytes"
 	"fmt"
 
 	"encoding/json"
@@ -30,9 +31,25 @@ func dataSourceAwsIamPolicyDocument() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"source_policy_documents": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateIAMPolicyJson,
+				},
+			},
+			"override_policy_documents": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateIAMPolicyJson,
+				},
+			},
 			"statement": {
 				Type:     schema.TypeList,
-				Required: true,
+				Optional: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"sid": {
@@ -98,13 +115,38 @@ func dataSourceAwsIamPolicyDocumentRead(d *schema.ResourceData, meta interface{}
 		Version: "2012-10-17",
 	}
 
+	// Statements of the source documents are merged in order and may be
+	// replaced by configured statements with the same sid.
+	sids := make(map[string]bool)
+	for _, v := range d.Get("source_policy_documents").([]interface{}) {
+		if v == nil || v.(string) == "" {
+			continue
+		}
+		sourceDoc, err := dataSourceAwsIamPolicyDocumentParse(v.(string))
+		if err != nil {
+			return fmt.Errorf("Error parsing source_policy_documents: %s", err)
+		}
+		if sourceDoc.Id != "" {
+			doc.Id = sourceDoc.Id
+		}
+		for _, stmt := range sourceDoc.Statements {
+			if stmt.Sid != "" {
+				if sids[stmt.Sid] {
+					return fmt.Errorf("Found duplicate sid (%s) in source_policy_documents", stmt.Sid)
+				}
+				sids[stmt.Sid] = true
+			}
+			doc.Statements = append(doc.Statements, stmt)
+		}
+	}
+
 	if policyId, hasPolicyId := d.GetOk("policy_id"); hasPolicyId {
 		doc.Id = policyId.(string)
 	}
 
 	var cfgStmts = d.Get("statement").([]interface{})
 	stmts := make([]*IAMPolicyStatement, len(cfgStmts))
-	doc.Statements = stmts
+	cfgSids := make(map[string]bool)
 	for i, stmtI := range cfgStmts {
 		cfgStmt := stmtI.(map[string]interface{})
 		stmt := &IAMPolicyStatement{
@@ -114,6 +156,12 @@ func dataSourceAwsIamPolicyDocumentRead(d *schema.ResourceData, meta interface{}
 		if sid, ok := cfgStmt["sid"]; ok {
 			stmt.Sid = sid.(string)
 		}
+		if stmt.Sid != "" {
+			if cfgSids[stmt.Sid] {
+				return fmt.Errorf("Found duplicate sid (%s) in statement", stmt.Sid)
+			}
+			cfgSids[stmt.Sid] = true
+		}
 
 		if actions := cfgStmt["actions"].(*schema.Set).List(); len(actions) > 0 {
 			stmt.Actions = iamPolicyDecodeConfigStringList(actions)
@@ -147,6 +195,24 @@ func dataSourceAwsIamPolicyDocumentRead(d *schema.ResourceData, meta interface{}
 
 		stmts[i] = stmt
 	}
+	doc.Merge(&IAMPolicyDoc{Statements: stmts})
+
+	// Statements of the override documents replace any previous statement
+	// with the same sid, with later documents taking precedence.
+	for _, v := range d.Get("override_policy_documents").([]interface{}) {
+		if v == nil || v.(string) == "" {
+			continue
+		}
+		overrideDoc, err := dataSourceAwsIamPolicyDocumentParse(v.(string))
+		if err != nil {
+			return fmt.Errorf("Error parsing override_policy_documents: %s", err)
+		}
+		doc.Merge(overrideDoc)
+	}
+
+	if doc.Statements == nil {
+		doc.Statements = []*IAMPolicyStatement{}
+	}
 
 	jsonDoc, err := json.MarshalIndent(doc, "", "  ")
 	if err != nil {
@@ -161,6 +227,37 @@ func dataSourceAwsIamPolicyDocumentRead(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
+// dataSourceAwsIamPolicyDocumentParse decodes a JSON policy document, whose
+// Statement may be either a single statement or a list of statements.
+func dataSourceAwsIamPolicyDocumentParse(s string) (*IAMPolicyDoc, error) {
+	var raw struct {
+		Version   string
+		Id        string
+		Statement json.RawMessage
+	}
+	if err := json.Unmarshal([]byte(s), &raw); err != nil {
+		return nil, err
+	}
+
+	doc := &IAMPolicyDoc{
+		Version: raw.Version,
+		Id:      raw.Id,
+	}
+
+	statement := bytes.TrimSpace(raw.Statement)
+	if len(statement) == 0 {
+		return doc, nil
+	}
+	if statement[0] == '{' {
+		statement = append(append([]byte{'['}, statement...), ']')
+	}
+	if err := json.Unmarshal(statement, &doc.Statements); err != nil {
+		return nil, err
+	}
+
+	return doc, nil
+}
+
 func dataSourceAwsIamPolicyDocumentReplaceVarsInList(in interface{}) interface{} {
 	switch v := in.(type) {
 	case string:
@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"encoding/json"
 	"fmt"
 	"testing"
 
@@ -28,6 +29,86 @@ func TestAccAWSDataSourceIAMPolicyDocument_basic(t *testing.T) {
 	})
 }
 
+func TestAccAWSDataSourceIAMPolicyDocument_sourceAndOverride(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIAMPolicyDocumentSourceAndOverrideConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStateValue("data.aws_iam_policy_document.test", "json",
+						testAccAWSIAMPolicyDocumentSourceAndOverrideExpectedJSON,
+					),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceAwsIamPolicyDocumentParseAndMerge(t *testing.T) {
+	source, err := dataSourceAwsIamPolicyDocumentParse(`{
+  "Version": "2012-10-17",
+  "Statement": {
+    "Sid": "Source",
+    "Effect": "Allow",
+    "Action": ["s3:GetObject", "s3:PutObject"],
+    "Resource": "*",
+    "Principal": {"AWS": ["arn:aws:iam::123456789012:root"]},
+    "Condition": {"Bool": {"aws:SecureTransport": "true"}}
+  }
+}`)
+	if err != nil {
+		t.Fatalf("error parsing source document: %s", err)
+	}
+	if len(source.Statements) != 1 {
+		t.Fatalf("expected 1 statement, got %d", len(source.Statements))
+	}
+
+	override, err := dataSourceAwsIamPolicyDocumentParse(`{
+  "Statement": [
+    {"Sid": "Source", "Effect": "Deny", "Action": "s3:*", "Resource": "*", "Principal": "*"},
+    {"Effect": "Allow", "Action": "ec2:DescribeInstances", "Resource": "*"}
+  ]
+}`)
+	if err != nil {
+		t.Fatalf("error parsing override document: %s", err)
+	}
+
+	doc := &IAMPolicyDoc{Version: "2012-10-17"}
+	doc.Merge(source)
+	doc.Merge(override)
+
+	b, err := json.Marshal(doc)
+	if err != nil {
+		t.Fatalf("error marshaling merged document: %s", err)
+	}
+
+	expected := `{"Version":"2012-10-17","Statement":[{"Sid":"Source","Effect":"Deny","Action":"s3:*","Resource":"*","Principal":"*"},{"Sid":"","Effect":"Allow","Action":"ec2:DescribeInstances","Resource":"*"}]}`
+	if string(b) != expected {
+		t.Fatalf("expected merged document:\n%s\ngot:\n%s", expected, string(b))
+	}
+
+	// Principals and conditions survive a round trip through the model.
+	b, err = json.Marshal(source)
+	if err != nil {
+		t.Fatalf("error marshaling source document: %s", err)
+	}
+	expected = `{"Version":"2012-10-17","Statement":[{"Sid":"Source","Effect":"Allow","Action":["s3:GetObject","s3:PutObject"],"Resource":"*","Principal":{"AWS":["arn:aws:iam::123456789012:root"]},"Condition":{"Bool":{"aws:SecureTransport":"true"}}}]}`
+	if string(b) != expected {
+		t.Fatalf("expected source document:\n%s\ngot:\n%s", expected, string(b))
+	}
+}
+
+func TestDataSourceAwsIamPolicyDocumentParse_invalidPrincipal(t *testing.T) {
+	_, err := dataSourceAwsIamPolicyDocumentParse(`{
+  "Statement": {"Effect": "Allow", "Action": "s3:*", "Resource": "*", "Principal": "arn:aws:iam::123456789012:root"}
+}`)
+	if err == nil {
+		t.Fatal("expected an error for a string principal other than \"*\"")
+	}
+}
+
 func testAccCheckStateValue(id, name, value string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[id]
@@ -187,3 +268,68 @@ var testAccAWSIAMPolicyDocumentExpectedJSON = `{
     }
   ]
 }`
+
+var testAccAWSIAMPolicyDocumentSourceAndOverrideConfig = `
+data "aws_iam_policy_document" "source" {
+  statement {
+    sid       = "SourceOnly"
+    actions   = ["s3:ListBucket"]
+    resources = ["arn:aws:s3:::foo"]
+  }
+
+  statement {
+    sid       = "Shared"
+    actions   = ["s3:GetObject"]
+    resources = ["arn:aws:s3:::foo/*"]
+  }
+}
+
+data "aws_iam_policy_document" "override" {
+  statement {
+    sid       = "Shared"
+    effect    = "Deny"
+    actions   = ["s3:*"]
+    resources = ["*"]
+  }
+}
+
+data "aws_iam_policy_document" "test" {
+  source_policy_documents   = ["${data.aws_iam_policy_document.source.json}"]
+  override_policy_documents = ["${data.aws_iam_policy_document.override.json}"]
+
+  statement {
+    sid       = "SourceOnly"
+    actions   = ["s3:ListAllMyBuckets"]
+    resources = ["*"]
+  }
+
+  statement {
+    actions   = ["ec2:DescribeInstances"]
+    resources = ["*"]
+  }
+}
+`
+
+var testAccAWSIAMPolicyDocumentSourceAndOverrideExpectedJSON = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "SourceOnly",
+      "Effect": "Allow",
+      "Action": "s3:ListAllMyBuckets",
+      "Resource": "*"
+    },
+    {
+      "Sid": "Shared",
+      "Effect": "Deny",
+      "Action": "s3:*",
+      "Resource": "*"
+    },
+    {
+      "Sid": "",
+      "Effect": "Allow",
+      "Action": "ec2:DescribeInstances",
+      "Resource": "*"
+    }
+  ]
+}`
@@ -98,6 +98,23 @@ func dataSourceAwsInstance() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"product_codes": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Set:      amiProductCodesHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"product_code_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"product_code_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"security_groups": {
 				Type:     schema.TypeSet,
 				Computed: true,
@@ -320,6 +337,10 @@ func instanceDescriptionAttributes(d *schema.ResourceData, instance *ec2.Instanc
 		d.Set("monitoring", monitoringState == "enabled" || monitoringState == "pending")
 	}
 
+	if err := d.Set("product_codes", amiProductCodes(instance.ProductCodes)); err != nil {
+		return err
+	}
+
 	d.Set("tags", tagsToMap(instance.Tags))
 
 	// Security Groups
@@ -14,9 +14,10 @@ import (
 )
 
 type dataSourceAwsIPRangesResult struct {
-	CreateDate string
-	Prefixes   []dataSourceAwsIPRangesPrefix
-	SyncToken  string
+	CreateDate   string
+	Prefixes     []dataSourceAwsIPRangesPrefix
+	Ipv6Prefixes []dataSourceAwsIPRangesIpv6Prefix `json:"ipv6_prefixes"`
+	SyncToken    string
 }
 
 type dataSourceAwsIPRangesPrefix struct {
@@ -25,6 +26,12 @@ type dataSourceAwsIPRangesPrefix struct {
 	Service  string
 }
 
+type dataSourceAwsIPRangesIpv6Prefix struct {
+	Ipv6Prefix string `json:"ipv6_prefix"`
+	Region     string
+	Service    string
+}
+
 func dataSourceAwsIPRanges() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceAwsIPRangesRead,
@@ -35,6 +42,11 @@ func dataSourceAwsIPRanges() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"ipv6_cidr_blocks": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"create_date": &schema.Schema{
 				Type:     schema.TypeString,
 				Computed: true,
@@ -121,6 +133,7 @@ func dataSourceAwsIPRangesRead(d *schema.ResourceData, meta interface{}) error {
 		services       = get("services")
 		noRegionFilter = regions.Len() == 0
 		prefixes       []string
+		ipv6Prefixes   []string
 	)
 
 	for _, e := range result.Prefixes {
@@ -136,7 +149,20 @@ func dataSourceAwsIPRangesRead(d *schema.ResourceData, meta interface{}) error {
 
 	}
 
-	if len(prefixes) == 0 {
+	for _, e := range result.Ipv6Prefixes {
+
+		var (
+			matchRegion  = noRegionFilter || regions.Contains(strings.ToLower(e.Region))
+			matchService = services.Contains(strings.ToLower(e.Service))
+		)
+
+		if matchRegion && matchService {
+			ipv6Prefixes = append(ipv6Prefixes, e.Ipv6Prefix)
+		}
+
+	}
+
+	if len(prefixes) == 0 && len(ipv6Prefixes) == 0 {
 		return fmt.Errorf(" No IP ranges result from filters")
 	}
 
@@ -146,6 +172,12 @@ func dataSourceAwsIPRangesRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error setting ip ranges: %s", err)
 	}
 
+	sort.Strings(ipv6Prefixes)
+
+	if err := d.Set("ipv6_cidr_blocks", ipv6Prefixes); err != nil {
+		return fmt.Errorf("Error setting ipv6 ranges: %s", err)
+	}
+
 	return nil
 
 }
@@ -79,6 +79,29 @@ func testAccAWSIPRanges(n string) resource.TestCheckFunc {
 			return fmt.Errorf("unexpected order of cidr_blocks: %s", cidrBlocks)
 		}
 
+		ipv6CidrBlockSize, err := strconv.Atoi(a["ipv6_cidr_blocks.#"])
+		if err != nil {
+			return err
+		}
+
+		var ipv6CidrBlocks sort.StringSlice = make([]string, ipv6CidrBlockSize)
+
+		for i := range ipv6CidrBlocks {
+
+			block := a[fmt.Sprintf("ipv6_cidr_blocks.%d", i)]
+
+			if _, _, err := net.ParseCIDR(block); err != nil {
+				return fmt.Errorf("malformed IPv6 CIDR block %s: %s", block, err)
+			}
+
+			ipv6CidrBlocks[i] = block
+
+		}
+
+		if !sort.IsSorted(ipv6CidrBlocks) {
+			return fmt.Errorf("unexpected order of ipv6_cidr_blocks: %s", ipv6CidrBlocks)
+		}
+
 		var (
 			regionMember      = regexp.MustCompile(`regions\.\d+`)
 			regions, services int
@@ -0,0 +1,91 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsLaunchTemplate() *schema.Resource {
+	s := launchTemplateComputedSchema(resourceAwsLaunchTemplate().Schema)
+	delete(s, "name_prefix")
+	delete(s, "update_default_version")
+
+	s["name"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+	}
+	s["version"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Default:  "$Default",
+	}
+
+	return &schema.Resource{
+		Read:   dataSourceAwsLaunchTemplateRead,
+		Schema: s,
+	}
+}
+
+// launchTemplateComputedSchema returns a copy of the given Launch Template
+// resource schema with every attribute marked as computed.
+func launchTemplateComputedSchema(in map[string]*schema.Schema) map[string]*schema.Schema {
+	out := make(map[string]*schema.Schema, len(in))
+	for k, v := range in {
+		s := &schema.Schema{
+			Type:     v.Type,
+			Computed: true,
+			Set:      v.Set,
+		}
+		switch elem := v.Elem.(type) {
+		case *schema.Resource:
+			s.Elem = &schema.Resource{
+				Schema: launchTemplateComputedSchema(elem.Schema),
+			}
+		case *schema.Schema:
+			s.Elem = &schema.Schema{Type: elem.Type}
+		}
+		out[k] = s
+	}
+	return out
+}
+
+func dataSourceAwsLaunchTemplateRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+	name := d.Get("name").(string)
+
+	log.Printf("[DEBUG] Reading Launch Template: %s", name)
+	output, err := conn.DescribeLaunchTemplates(&ec2.DescribeLaunchTemplatesInput{
+		LaunchTemplateNames: []*string{aws.String(name)},
+	})
+	if err != nil {
+		return fmt.Errorf("Error reading Launch Template (%s): %s", name, err)
+	}
+
+	if len(output.LaunchTemplates) == 0 {
+		return fmt.Errorf("Launch Template (%s) not found", name)
+	}
+
+	lt := output.LaunchTemplates[0]
+	id := aws.StringValue(lt.LaunchTemplateId)
+
+	version, err := describeLaunchTemplateVersion(conn, id, d.Get("version").(string))
+	if err != nil {
+		return err
+	}
+
+	d.SetId(id)
+
+	client := meta.(*AWSClient)
+	d.Set("arn", arnString(client.partition, client.region, "ec2", client.accountid, fmt.Sprintf("launch-template/%s", id)))
+	d.Set("name", lt.LaunchTemplateName)
+	d.Set("default_version", lt.DefaultVersionNumber)
+	d.Set("latest_version", lt.LatestVersionNumber)
+	d.Set("description", version.VersionDescription)
+	d.Set("tags", tagsToMap(lt.Tags))
+
+	return setLaunchTemplateData(d, version.LaunchTemplateData)
+}
@@ -0,0 +1,75 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/service/mediaconvert"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsMediaConvertEndpoint() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsMediaConvertEndpointRead,
+
+		Schema: map[string]*schema.Schema{
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"queue_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "Default",
+			},
+			"queue_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsMediaConvertEndpointRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).mediaconvertconn
+
+	log.Printf("[DEBUG] Reading MediaConvert endpoints")
+	resp, err := conn.DescribeEndpoints(&mediaconvert.DescribeEndpointsInput{})
+	if err != nil {
+		return fmt.Errorf("Error describing MediaConvert endpoints: %s", err)
+	}
+	if len(resp.Endpoints) == 0 {
+		return fmt.Errorf("No MediaConvert endpoint found for this account")
+	}
+
+	url := aws.StringValue(resp.Endpoints[0].Url)
+	accountConn := mediaConvertAccountConn(conn, url)
+
+	queueName := d.Get("queue_name").(string)
+	queueResp, err := accountConn.GetQueue(&mediaconvert.GetQueueInput{
+		Name: aws.String(queueName),
+	})
+	if err != nil {
+		return fmt.Errorf("Error reading MediaConvert queue (%s): %s", queueName, err)
+	}
+
+	d.SetId(url)
+	d.Set("url", url)
+	d.Set("queue_arn", queueResp.Queue.Arn)
+
+	return nil
+}
+
+// mediaConvertAccountConn returns a copy of conn that sends requests to the
+// account specific endpoint, which every MediaConvert operation other than
+// DescribeEndpoints requires.
+func mediaConvertAccountConn(conn *mediaconvert.MediaConvert, url string) *mediaconvert.MediaConvert {
+	info := conn.ClientInfo
+	info.Endpoint = url
+
+	return &mediaconvert.MediaConvert{
+		Client: client.New(*conn.Config.Copy(), info, conn.Handlers.Copy()),
+	}
+}
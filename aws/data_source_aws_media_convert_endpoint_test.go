@@ -0,0 +1,28 @@
+package aws
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAwsMediaConvertEndpoint_basic(t *testing.T) {
+	testAccErrorCheckTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsMediaConvertEndpointConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("data.aws_media_convert_endpoint.test", "url", regexp.MustCompile(`^https://[^.]+\.mediaconvert\.[^.]+\.amazonaws\.com`)),
+					resource.TestMatchResourceAttr("data.aws_media_convert_endpoint.test", "queue_arn", regexp.MustCompile(`^arn:[^:]+:mediaconvert:[^:]+:[^:]+:queues/Default$`)),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceAwsMediaConvertEndpointConfig = `
+data "aws_media_convert_endpoint" "test" {}
+`
@@ -0,0 +1,87 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsSfnStateMachine() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsSfnStateMachineRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"definition": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"role_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsSfnStateMachineRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sfnconn
+	name := d.Get("name").(string)
+
+	var arns []string
+	err := conn.ListStateMachinesPages(&sfn.ListStateMachinesInput{}, func(page *sfn.ListStateMachinesOutput, lastPage bool) bool {
+		for _, sm := range page.StateMachines {
+			if aws.StringValue(sm.Name) == name {
+				arns = append(arns, aws.StringValue(sm.StateMachineArn))
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return fmt.Errorf("Error listing Step Function State Machines: %s", err)
+	}
+
+	if len(arns) == 0 {
+		return fmt.Errorf("No Step Function State Machine with name %q found in this region.", name)
+	}
+	if len(arns) > 1 {
+		return fmt.Errorf("Multiple Step Function State Machines with name %q found in this region.", name)
+	}
+
+	log.Printf("[DEBUG] Reading Step Function State Machine: %s", arns[0])
+	sm, err := conn.DescribeStateMachine(&sfn.DescribeStateMachineInput{
+		StateMachineArn: aws.String(arns[0]),
+	})
+	if err != nil {
+		return fmt.Errorf("Error reading Step Function State Machine (%s): %s", arns[0], err)
+	}
+
+	d.SetId(aws.StringValue(sm.StateMachineArn))
+	d.Set("arn", sm.StateMachineArn)
+	d.Set("creation_date", aws.TimeValue(sm.CreationDate).Format(time.RFC3339))
+	d.Set("definition", sm.Definition)
+	d.Set("name", sm.Name)
+	d.Set("role_arn", sm.RoleArn)
+	d.Set("status", sm.Status)
+
+	return nil
+}
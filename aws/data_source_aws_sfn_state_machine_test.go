@@ -0,0 +1,43 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAwsSfnStateMachine(t *testing.T) {
+	dataSourceName := "data.aws_sfn_state_machine.test"
+	resourceName := "aws_sfn_state_machine.foo"
+	rName := acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsSfnStateMachineConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "creation_date", resourceName, "creation_date"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "definition", resourceName, "definition"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "role_arn", resourceName, "role_arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "status", "ACTIVE"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAwsSfnStateMachineConfig(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "aws_sfn_state_machine" "test" {
+  name = "${aws_sfn_state_machine.foo.name}"
+}
+`, testAccAWSSfnStateMachineConfig(rName, 5))
+}
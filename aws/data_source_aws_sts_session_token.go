@@ -0,0 +1,151 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func dataSourceAwsStsSessionToken() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsStsSessionTokenRead,
+
+		Schema: map[string]*schema.Schema{
+			"duration_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      3600,
+				ValidateFunc: validation.IntBetween(900, 129600),
+			},
+			"external_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(2, 1224),
+			},
+			"policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateIAMPolicyJson,
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArn,
+			},
+			"role_session_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(2, 64),
+			},
+
+			// Computed values.
+			"access_key_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"expiration": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"secret_access_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"session_token": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+// stsAssumeRolePropagationTimeout is how long to retry AssumeRole while a
+// newly created role or its trust policy propagates through IAM.
+const stsAssumeRolePropagationTimeout = 2 * time.Minute
+
+// stsSessionTokenAssumeRoleKeys are the arguments only used when assuming a
+// role.
+var stsSessionTokenAssumeRoleKeys = []string{
+	"external_id",
+	"policy",
+	"role_session_name",
+}
+
+func dataSourceAwsStsSessionTokenRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).stsconn
+
+	var credentials *sts.Credentials
+	if v, ok := d.GetOk("role_arn"); ok {
+		roleArn := v.(string)
+
+		input := &sts.AssumeRoleInput{
+			DurationSeconds: aws.Int64(int64(d.Get("duration_seconds").(int))),
+			RoleArn:         aws.String(roleArn),
+			RoleSessionName: aws.String(fmt.Sprintf("terraform-%d", time.Now().UnixNano())),
+		}
+		if v, ok := d.GetOk("external_id"); ok {
+			input.ExternalId = aws.String(v.(string))
+		}
+		if v, ok := d.GetOk("policy"); ok {
+			input.Policy = aws.String(v.(string))
+		}
+		if v, ok := d.GetOk("role_session_name"); ok {
+			input.RoleSessionName = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Assuming IAM Role (%s)", roleArn)
+		var output *sts.AssumeRoleOutput
+		err := resource.Retry(stsAssumeRolePropagationTimeout, func() *resource.RetryError {
+			var err error
+			output, err = conn.AssumeRole(input)
+			if err != nil {
+				if isAWSErr(err, "AccessDenied", "") {
+					return resource.RetryableError(err)
+				}
+				return resource.NonRetryableError(err)
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("Error assuming IAM Role (%s): %s", roleArn, err)
+		}
+		credentials = output.Credentials
+	} else {
+		for _, k := range stsSessionTokenAssumeRoleKeys {
+			if _, ok := d.GetOk(k); ok {
+				return fmt.Errorf("%q can only be set together with role_arn", k)
+			}
+		}
+
+		input := &sts.GetSessionTokenInput{
+			DurationSeconds: aws.Int64(int64(d.Get("duration_seconds").(int))),
+		}
+
+		log.Printf("[DEBUG] Getting STS session token")
+		output, err := conn.GetSessionToken(input)
+		if err != nil {
+			return fmt.Errorf("Error getting STS session token: %s", err)
+		}
+		credentials = output.Credentials
+	}
+
+	if credentials == nil {
+		return fmt.Errorf("Error getting STS session token: empty credentials")
+	}
+
+	d.SetId(aws.StringValue(credentials.AccessKeyId))
+	d.Set("access_key_id", credentials.AccessKeyId)
+	d.Set("secret_access_key", credentials.SecretAccessKey)
+	d.Set("session_token", credentials.SessionToken)
+	d.Set("expiration", aws.TimeValue(credentials.Expiration).Format(time.RFC3339))
+
+	return nil
+}
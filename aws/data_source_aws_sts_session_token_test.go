@@ -0,0 +1,81 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSStsSessionTokenDataSource_assumeRole(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+	dataSourceName := "data.aws_sts_session_token.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSStsSessionTokenDataSourceConfig_assumeRole(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceName, "access_key_id", regexp.MustCompile("^ASIA")),
+					resource.TestCheckResourceAttrSet(dataSourceName, "secret_access_key"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "session_token"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "expiration"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSStsSessionTokenDataSource_assumeRoleArgumentsWithoutRole(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSStsSessionTokenDataSourceConfig_externalIdWithoutRole,
+				ExpectError: regexp.MustCompile(`"external_id" can only be set together with role_arn`),
+			},
+		},
+	})
+}
+
+func testAccAWSStsSessionTokenDataSourceConfig_assumeRole(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = "%s"
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "AWS": "arn:aws:iam::${data.aws_caller_identity.current.account_id}:root"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+EOF
+}
+
+data "aws_sts_session_token" "test" {
+  role_arn          = "${aws_iam_role.test.arn}"
+  role_session_name = "%s"
+  duration_seconds  = 900
+}
+`, rName, rName)
+}
+
+const testAccAWSStsSessionTokenDataSourceConfig_externalIdWithoutRole = `
+data "aws_sts_session_token" "test" {
+  external_id = "test"
+}
+`
@@ -6,6 +6,7 @@ import (
 	"log"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/jen20/awspolicyequivalence"
@@ -60,6 +61,13 @@ func suppressEquivalentJsonDiffs(k, old, new string, d *schema.ResourceData) boo
 	return jsonBytesEqual(ob.Bytes(), nb.Bytes())
 }
 
+// suppressEquivalentJsonOrYamlDiffs suppresses differences between JSON or
+// YAML documents that decode to the same value, e.g. a CloudFormation
+// template that was only reformatted or converted between JSON and YAML.
+func suppressEquivalentJsonOrYamlDiffs(k, old, new string, d *schema.ResourceData) bool {
+	return jsonOrYamlStringsEqual(old, new)
+}
+
 func suppressOpenIdURL(k, old, new string, d *schema.ResourceData) bool {
 	oldUrl, err := url.Parse(old)
 	if err != nil {
@@ -85,3 +93,20 @@ func suppressAutoscalingGroupAvailabilityZoneDiffs(k, old, new string, d *schema
 
 	return false
 }
+
+// suppressEquivalentRFC3339TimeDiffs suppresses differences between RFC3339
+// timestamps that denote the same instant, e.g. a configured time with a
+// non-UTC offset and the UTC time returned by the API.
+func suppressEquivalentRFC3339TimeDiffs(k, old, new string, d *schema.ResourceData) bool {
+	oldTime, err := time.Parse(time.RFC3339, old)
+	if err != nil {
+		return false
+	}
+
+	newTime, err := time.Parse(time.RFC3339, new)
+	if err != nil {
+		return false
+	}
+
+	return oldTime.Equal(newTime)
+}
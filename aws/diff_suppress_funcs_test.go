@@ -29,3 +29,45 @@ func TestSuppressEquivalentJsonDiffsWhitespaceAndNoWhitespace(t *testing.T) {
 		t.Errorf("Expected suppressEquivalentJsonDiffs to return false for %s == %s", noWhitespaceDiff, whitespaceDiff)
 	}
 }
+
+func TestSuppressEquivalentJsonOrYamlDiffs(t *testing.T) {
+	d := new(schema.ResourceData)
+
+	json := `{"Resources":{"Topic":{"Type":"AWS::SNS::Topic"}}}`
+	yaml := `
+Resources:
+  Topic:
+    Type: AWS::SNS::Topic
+`
+
+	if !suppressEquivalentJsonOrYamlDiffs("", json, yaml, d) {
+		t.Errorf("Expected suppressEquivalentJsonOrYamlDiffs to return true for %s == %s", json, yaml)
+	}
+
+	yamlDiff := `
+Resources:
+  Topic:
+    Type: AWS::SQS::Queue
+`
+
+	if suppressEquivalentJsonOrYamlDiffs("", json, yamlDiff, d) {
+		t.Errorf("Expected suppressEquivalentJsonOrYamlDiffs to return false for %s == %s", json, yamlDiff)
+	}
+}
+
+func TestSuppressEquivalentRFC3339TimeDiffs(t *testing.T) {
+	d := new(schema.ResourceData)
+
+	utc := "2018-05-01T12:00:00Z"
+	offset := "2018-05-01T14:00:00+02:00"
+
+	if !suppressEquivalentRFC3339TimeDiffs("", utc, offset, d) {
+		t.Errorf("Expected suppressEquivalentRFC3339TimeDiffs to return true for %s == %s", utc, offset)
+	}
+
+	offsetDiff := "2018-05-01T12:00:00+02:00"
+
+	if suppressEquivalentRFC3339TimeDiffs("", utc, offsetDiff, d) {
+		t.Errorf("Expected suppressEquivalentRFC3339TimeDiffs to return false for %s == %s", utc, offsetDiff)
+	}
+}
@@ -2,6 +2,7 @@ package aws
 
 import (
 	"encoding/json"
+	"fmt"
 	"sort"
 )
 
@@ -99,6 +100,101 @@ func (cs IAMPolicyStatementConditionSet) MarshalJSON() ([]byte, error) {
 	return json.Marshal(&raw)
 }
 
+func (ps *IAMPolicyStatementPrincipalSet) UnmarshalJSON(b []byte) error {
+	var out IAMPolicyStatementPrincipalSet
+
+	var data interface{}
+	if err := json.Unmarshal(b, &data); err != nil {
+		return err
+	}
+
+	switch t := data.(type) {
+	case string:
+		// The only principal that can be given as a plain string is "*".
+		if t != "*" {
+			return fmt.Errorf("Unsupported principal %q, expected \"*\" or a map of principal types", t)
+		}
+		out = append(out, IAMPolicyStatementPrincipal{Type: "*", Identifiers: []string{"*"}})
+	case map[string]interface{}:
+		for key, value := range t {
+			identifiers, err := iamPolicyDecodeJSONStringList(value)
+			if err != nil {
+				return fmt.Errorf("Unsupported principal %q: %s", key, err)
+			}
+			out = append(out, IAMPolicyStatementPrincipal{Type: key, Identifiers: identifiers})
+		}
+	default:
+		return fmt.Errorf("Unsupported data type %T for IAMPolicyStatementPrincipalSet", t)
+	}
+
+	*ps = out
+	return nil
+}
+
+func (cs *IAMPolicyStatementConditionSet) UnmarshalJSON(b []byte) error {
+	var out IAMPolicyStatementConditionSet
+
+	var data map[string]map[string]interface{}
+	if err := json.Unmarshal(b, &data); err != nil {
+		return err
+	}
+
+	for test, variables := range data {
+		for variable, values := range variables {
+			v, err := iamPolicyDecodeJSONStringList(values)
+			if err != nil {
+				return fmt.Errorf("Unsupported condition %s %q: %s", test, variable, err)
+			}
+			out = append(out, IAMPolicyStatementCondition{Test: test, Variable: variable, Values: v})
+		}
+	}
+
+	*cs = out
+	return nil
+}
+
+// Merge adds the statements of newDoc to the document. A statement replaces
+// the existing statement with the same non-empty Sid and is appended
+// otherwise.
+func (doc *IAMPolicyDoc) Merge(newDoc *IAMPolicyDoc) {
+	for _, newStatement := range newDoc.Statements {
+		replaced := false
+		if newStatement.Sid != "" {
+			for i, statement := range doc.Statements {
+				if statement.Sid == newStatement.Sid {
+					doc.Statements[i] = newStatement
+					replaced = true
+					break
+				}
+			}
+		}
+		if !replaced {
+			doc.Statements = append(doc.Statements, newStatement)
+		}
+	}
+}
+
+// iamPolicyDecodeJSONStringList converts a decoded JSON string or list of
+// strings to the string or []string expected when marshaling a policy.
+func iamPolicyDecodeJSONStringList(v interface{}) (interface{}, error) {
+	switch t := v.(type) {
+	case string:
+		return t, nil
+	case []interface{}:
+		out := make([]string, len(t))
+		for i, item := range t {
+			s, ok := item.(string)
+			if !ok {
+				return nil, fmt.Errorf("unsupported list item type %T", item)
+			}
+			out[i] = s
+		}
+		return out, nil
+	default:
+		return nil, fmt.Errorf("unsupported type %T", t)
+	}
+}
+
 func iamPolicyDecodeConfigStringList(lI []interface{}) interface{} {
 	if len(lI) == 1 {
 		return lI[0].(string)
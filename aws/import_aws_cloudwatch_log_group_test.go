@@ -24,7 +24,7 @@ func TestAccAWSCloudWatchLogGroup_importBasic(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"retention_in_days"}, //this has a default value
+				ImportStateVerifyIgnore: []string{"retention_in_days", "skip_destroy"}, //these have default values
 			},
 		},
 	})
@@ -0,0 +1,93 @@
+package finder
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// VpcEndpointByID returns the VPC Endpoint corresponding to the specified ID.
+// Returns NotFoundError if no endpoint is found or the endpoint has been deleted.
+func VpcEndpointByID(conn *ec2.EC2, id string) (*ec2.VpcEndpoint, error) {
+	input := &ec2.DescribeVpcEndpointsInput{
+		VpcEndpointIds: []*string{aws.String(id)},
+	}
+
+	output, err := conn.DescribeVpcEndpoints(input)
+
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "InvalidVpcEndpointId.NotFound" {
+			return nil, &resource.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+		return nil, err
+	}
+
+	for _, vpce := range output.VpcEndpoints {
+		if aws.StringValue(vpce.VpcEndpointId) != id {
+			continue
+		}
+
+		// Deleted endpoints are returned for a short time after deletion.
+		if strings.EqualFold(aws.StringValue(vpce.State), ec2.StateDeleted) {
+			break
+		}
+
+		return vpce, nil
+	}
+
+	return nil, &resource.NotFoundError{
+		LastRequest:  input,
+		LastResponse: output,
+		Message:      fmt.Sprintf("VPC Endpoint (%s) not found", id),
+	}
+}
+
+// VolumeModificationByID returns the most recent modification of the specified EBS Volume.
+// Returns NotFoundError if the volume has never been modified.
+func VolumeModificationByID(conn *ec2.EC2, id string) (*ec2.VolumeModification, error) {
+	input := &ec2.DescribeVolumesModificationsInput{
+		VolumeIds: []*string{aws.String(id)},
+	}
+
+	output, err := conn.DescribeVolumesModifications(input)
+
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "InvalidVolume.NotFound" {
+			return nil, &resource.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+		return nil, err
+	}
+
+	// Modifications are retained for a while after they complete, so pick
+	// the one started last.
+	var latest *ec2.VolumeModification
+	for _, modification := range output.VolumesModifications {
+		if aws.StringValue(modification.VolumeId) != id {
+			continue
+		}
+
+		if latest == nil || aws.TimeValue(modification.StartTime).After(aws.TimeValue(latest.StartTime)) {
+			latest = modification
+		}
+	}
+
+	if latest != nil {
+		return latest, nil
+	}
+
+	return nil, &resource.NotFoundError{
+		LastRequest:  input,
+		LastResponse: output,
+		Message:      fmt.Sprintf("EBS Volume (%s) modification not found", id),
+	}
+}
@@ -0,0 +1,51 @@
+package waiter
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+// VpcEndpointState fetches the VPC Endpoint and its State.
+// The API reports states in lower case, so they are normalized here.
+func VpcEndpointState(conn *ec2.EC2, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		vpce, err := finder.VpcEndpointByID(conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return vpce, strings.ToLower(aws.StringValue(vpce.State)), nil
+	}
+}
+
+// VolumeModificationState fetches the most recent modification of an EBS Volume and its ModificationState.
+func VolumeModificationState(conn *ec2.EC2, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		modification, err := finder.VolumeModificationByID(conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if state := aws.StringValue(modification.ModificationState); state == ec2.VolumeModificationStateFailed {
+			return modification, state, fmt.Errorf("EBS Volume (%s) modification failed: %s", id, aws.StringValue(modification.StatusMessage))
+		}
+
+		return modification, aws.StringValue(modification.ModificationState), nil
+	}
+}
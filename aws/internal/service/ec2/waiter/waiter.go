@@ -0,0 +1,84 @@
+package waiter
+
+import (
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const (
+	// Default maximum amount of time to wait for a VPC Endpoint to become available
+	VpcEndpointAvailableTimeout = 10 * time.Minute
+
+	// Default maximum amount of time to wait for a VPC Endpoint to be deleted
+	VpcEndpointDeletedTimeout = 10 * time.Minute
+
+	vpcEndpointStatePendingAcceptance = "pendingacceptance"
+
+	// Default maximum amount of time to wait for an EBS Volume modification to take effect
+	VolumeModificationTimeout = 10 * time.Minute
+)
+
+// VpcEndpointAvailable waits for a VPC Endpoint to return available.
+// Endpoints to services that require acceptance stop in pendingAcceptance.
+func VpcEndpointAvailable(conn *ec2.EC2, id string, timeout time.Duration) (*ec2.VpcEndpoint, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{strings.ToLower(ec2.StatePending)},
+		Target:     []string{strings.ToLower(ec2.StateAvailable), vpcEndpointStatePendingAcceptance},
+		Refresh:    VpcEndpointState(conn, id),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.VpcEndpoint); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// VpcEndpointDeleted waits for a VPC Endpoint to be deleted
+func VpcEndpointDeleted(conn *ec2.EC2, id string, timeout time.Duration) (*ec2.VpcEndpoint, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{strings.ToLower(ec2.StateAvailable), strings.ToLower(ec2.StateDeleting)},
+		Target:     []string{},
+		Refresh:    VpcEndpointState(conn, id),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.VpcEndpoint); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// VolumeModificationOptimizing waits for an EBS Volume modification to return optimizing or completed.
+// The new size and type are usable as soon as the volume starts optimizing.
+func VolumeModificationOptimizing(conn *ec2.EC2, id string, timeout time.Duration) (*ec2.VolumeModification, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ec2.VolumeModificationStateModifying},
+		Target:     []string{ec2.VolumeModificationStateOptimizing, ec2.VolumeModificationStateCompleted},
+		Refresh:    VolumeModificationState(conn, id),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.VolumeModification); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,86 @@
+package finder
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// EnvironmentByID returns the Elastic Beanstalk Environment corresponding to the specified ID.
+// Returns NotFoundError if no environment is found.
+func EnvironmentByID(conn *elasticbeanstalk.ElasticBeanstalk, id string) (*elasticbeanstalk.EnvironmentDescription, error) {
+	input := &elasticbeanstalk.DescribeEnvironmentsInput{
+		EnvironmentIds: []*string{aws.String(id)},
+	}
+
+	output, err := conn.DescribeEnvironments(input)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, env := range output.Environments {
+		if aws.StringValue(env.EnvironmentId) == id {
+			return env, nil
+		}
+	}
+
+	return nil, &resource.NotFoundError{
+		LastRequest:  input,
+		LastResponse: output,
+		Message:      fmt.Sprintf("Elastic Beanstalk Environment (%s) not found", id),
+	}
+}
+
+// EnvironmentErrors returns the ERROR severity events emitted by the specified
+// Elastic Beanstalk Environment since the given time, oldest first.
+// Returns nil if there are no such events.
+func EnvironmentErrors(conn *elasticbeanstalk.ElasticBeanstalk, id string, since time.Time) (*multierror.Error, error) {
+	output, err := conn.DescribeEvents(&elasticbeanstalk.DescribeEventsInput{
+		EnvironmentId: aws.String(id),
+		Severity:      aws.String(elasticbeanstalk.EventSeverityError),
+		StartTime:     aws.Time(since),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("[Err] Unable to get Elastic Beanstalk Evironment events: %s", err)
+	}
+
+	var events environmentErrors
+	for _, event := range output.Events {
+		e := &environmentError{
+			eventDate:     event.EventDate,
+			environmentID: id,
+			message:       event.Message,
+		}
+		events = append(events, e)
+	}
+	sort.Sort(events)
+
+	var result *multierror.Error
+	for _, event := range events {
+		result = multierror.Append(result, event)
+	}
+
+	return result, nil
+}
+
+type environmentError struct {
+	eventDate     *time.Time
+	environmentID string
+	message       *string
+}
+
+func (e environmentError) Error() string {
+	return e.eventDate.String() + " (" + e.environmentID + ") : " + *e.message
+}
+
+type environmentErrors []*environmentError
+
+func (e environmentErrors) Len() int           { return len(e) }
+func (e environmentErrors) Swap(i, j int)      { e[i], e[j] = e[j], e[i] }
+func (e environmentErrors) Less(i, j int) bool { return e[i].eventDate.Before(*e[j].eventDate) }
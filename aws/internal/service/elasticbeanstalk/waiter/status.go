@@ -0,0 +1,40 @@
+package waiter
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/elasticbeanstalk/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+// EnvironmentStatus fetches the Environment and its Status.
+// Any ERROR events emitted by the environment since the given time fail the refresh.
+func EnvironmentStatus(conn *elasticbeanstalk.ElasticBeanstalk, id string, since time.Time) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		env, err := finder.EnvironmentByID(conn, id)
+
+		if tfresource.NotFound(err) {
+			// Sometimes AWS just has consistency issues and doesn't see
+			// our environment yet. Return an empty state.
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", fmt.Errorf("[Err] Error waiting for Elastic Beanstalk Environment state: %s", err)
+		}
+
+		envErrors, err := finder.EnvironmentErrors(conn, id, since)
+		if err != nil {
+			return nil, "", err
+		}
+		if envErrors != nil {
+			return nil, "", envErrors
+		}
+
+		return env, aws.StringValue(env.Status), nil
+	}
+}
@@ -0,0 +1,62 @@
+package waiter
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+const (
+	// Time to wait before the first Environment status check
+	EnvironmentStatusDelay = 10 * time.Second
+
+	// Smallest time to wait between Environment status checks when no poll interval is set
+	EnvironmentStatusMinTimeout = 3 * time.Second
+)
+
+// EnvironmentReady waits for an Environment to return Ready
+func EnvironmentReady(conn *elasticbeanstalk.ElasticBeanstalk, id string, since time.Time, timeout, pollInterval time.Duration) (*elasticbeanstalk.EnvironmentDescription, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			elasticbeanstalk.EnvironmentStatusLaunching,
+			elasticbeanstalk.EnvironmentStatusUpdating,
+		},
+		Target:       []string{elasticbeanstalk.EnvironmentStatusReady},
+		Refresh:      EnvironmentStatus(conn, id, since),
+		Timeout:      timeout,
+		Delay:        tfresource.Jitter(EnvironmentStatusDelay, 0.25),
+		PollInterval: pollInterval,
+		MinTimeout:   EnvironmentStatusMinTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*elasticbeanstalk.EnvironmentDescription); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// EnvironmentTerminated waits for an Environment to return Terminated
+func EnvironmentTerminated(conn *elasticbeanstalk.ElasticBeanstalk, id string, since time.Time, timeout, pollInterval time.Duration) (*elasticbeanstalk.EnvironmentDescription, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{elasticbeanstalk.EnvironmentStatusTerminating},
+		Target:       []string{elasticbeanstalk.EnvironmentStatusTerminated},
+		Refresh:      EnvironmentStatus(conn, id, since),
+		Timeout:      timeout,
+		Delay:        tfresource.Jitter(EnvironmentStatusDelay, 0.25),
+		PollInterval: pollInterval,
+		MinTimeout:   EnvironmentStatusMinTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*elasticbeanstalk.EnvironmentDescription); ok {
+		return output, err
+	}
+
+	return nil, err
+}
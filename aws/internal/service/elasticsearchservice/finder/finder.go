@@ -0,0 +1,37 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	elasticsearch "github.com/aws/aws-sdk-go/service/elasticsearchservice"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// DomainByName returns the status of the Elasticsearch Domain corresponding to the specified name.
+// Returns NotFoundError if no domain is found.
+func DomainByName(conn *elasticsearch.ElasticsearchService, name string) (*elasticsearch.ElasticsearchDomainStatus, error) {
+	input := &elasticsearch.DescribeElasticsearchDomainInput{
+		DomainName: aws.String(name),
+	}
+
+	output, err := conn.DescribeElasticsearchDomain(input)
+
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == elasticsearch.ErrCodeResourceNotFoundException {
+			return nil, &resource.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+		return nil, err
+	}
+
+	if output == nil || output.DomainStatus == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.DomainStatus, nil
+}
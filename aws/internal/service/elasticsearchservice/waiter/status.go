@@ -0,0 +1,23 @@
+package waiter
+
+import (
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	elasticsearch "github.com/aws/aws-sdk-go/service/elasticsearchservice"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/elasticsearchservice/finder"
+)
+
+// DomainProcessing fetches the Domain and whether it is processing a configuration change, as "true" or "false".
+func DomainProcessing(conn *elasticsearch.ElasticsearchService, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		domain, err := finder.DomainByName(conn, name)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return domain, strconv.FormatBool(aws.BoolValue(domain.Processing)), nil
+	}
+}
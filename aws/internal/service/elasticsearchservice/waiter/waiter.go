@@ -0,0 +1,33 @@
+package waiter
+
+import (
+	"time"
+
+	elasticsearch "github.com/aws/aws-sdk-go/service/elasticsearchservice"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const (
+	// Maximum amount of time to wait for a Domain to finish processing a configuration change
+	DomainProcessedTimeout = 60 * time.Minute
+)
+
+// DomainProcessed waits for a Domain to finish processing any configuration change.
+// Each change must be waited on, as a Domain rejects updates while it is processing.
+func DomainProcessed(conn *elasticsearch.ElasticsearchService, name string, timeout time.Duration) (*elasticsearch.ElasticsearchDomainStatus, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"true"},
+		Target:     []string{"false"},
+		Refresh:    DomainProcessing(conn, name),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*elasticsearch.ElasticsearchDomainStatus); ok {
+		return output, err
+	}
+
+	return nil, err
+}
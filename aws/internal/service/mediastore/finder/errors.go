@@ -0,0 +1,11 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/mediastore"
+)
+
+func isContainerNotFound(err error) bool {
+	awsErr, ok := err.(awserr.Error)
+	return ok && awsErr.Code() == mediastore.ErrCodeContainerNotFoundException
+}
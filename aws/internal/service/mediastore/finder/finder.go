@@ -0,0 +1,36 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mediastore"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// ContainerByName returns the MediaStore Container corresponding to the specified name.
+// Returns NotFoundError if no container is found.
+func ContainerByName(conn *mediastore.MediaStore, name string) (*mediastore.Container, error) {
+	input := &mediastore.DescribeContainerInput{
+		ContainerName: aws.String(name),
+	}
+
+	output, err := conn.DescribeContainer(input)
+
+	if err != nil {
+		if isContainerNotFound(err) {
+			return nil, &resource.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+		return nil, err
+	}
+
+	if output == nil || output.Container == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.Container, nil
+}
@@ -0,0 +1,26 @@
+package waiter
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mediastore"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/mediastore/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+// ContainerStatus fetches the Container and its Status
+func ContainerStatus(conn *mediastore.MediaStore, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		container, err := finder.ContainerByName(conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return container, aws.StringValue(container.Status), nil
+	}
+}
@@ -0,0 +1,59 @@
+package waiter
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/mediastore"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+const (
+	// Maximum amount of time to wait for a Container to become active
+	ContainerActiveTimeout = 10 * time.Minute
+
+	// Maximum amount of time to wait for a Container to be deleted
+	ContainerDeletedTimeout = 5 * time.Minute
+
+	// Time to wait before the first Container status check
+	ContainerStatusDelay = 10 * time.Second
+)
+
+// ContainerActive waits for a Container to return ACTIVE
+func ContainerActive(conn *mediastore.MediaStore, name string) (*mediastore.Container, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{mediastore.ContainerStatusCreating},
+		Target:     []string{mediastore.ContainerStatusActive},
+		Refresh:    ContainerStatus(conn, name),
+		Timeout:    ContainerActiveTimeout,
+		Delay:      tfresource.Jitter(ContainerStatusDelay, 0.25),
+		MinTimeout: 3 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*mediastore.Container); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// ContainerDeleted waits for a Container to be deleted
+func ContainerDeleted(conn *mediastore.MediaStore, name string) (*mediastore.Container, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{mediastore.ContainerStatusActive, mediastore.ContainerStatusDeleting},
+		Target:     []string{},
+		Refresh:    ContainerStatus(conn, name),
+		Timeout:    ContainerDeletedTimeout,
+		MinTimeout: 3 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*mediastore.Container); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,53 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ses"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// IdentityVerificationAttributesByIdentity returns the verification attributes of the specified SES identity.
+// Returns NotFoundError if the identity is not found.
+func IdentityVerificationAttributesByIdentity(conn *ses.SES, identity string) (*ses.IdentityVerificationAttributes, error) {
+	input := &ses.GetIdentityVerificationAttributesInput{
+		Identities: []*string{aws.String(identity)},
+	}
+
+	output, err := conn.GetIdentityVerificationAttributes(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.VerificationAttributes[identity] == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.VerificationAttributes[identity], nil
+}
+
+// IdentityDkimAttributesByIdentity returns the DKIM attributes of the specified SES identity.
+// Returns NotFoundError if the identity is not found.
+func IdentityDkimAttributesByIdentity(conn *ses.SES, identity string) (*ses.IdentityDkimAttributes, error) {
+	input := &ses.GetIdentityDkimAttributesInput{
+		Identities: []*string{aws.String(identity)},
+	}
+
+	output, err := conn.GetIdentityDkimAttributes(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.DkimAttributes[identity] == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.DkimAttributes[identity], nil
+}
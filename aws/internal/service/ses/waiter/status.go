@@ -0,0 +1,26 @@
+package waiter
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ses"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ses/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+// IdentityVerificationStatus fetches the verification attributes of an Identity and its VerificationStatus.
+func IdentityVerificationStatus(conn *ses.SES, identity string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		attrs, err := finder.IdentityVerificationAttributesByIdentity(conn, identity)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return attrs, aws.StringValue(attrs.VerificationStatus), nil
+	}
+}
@@ -0,0 +1,35 @@
+package waiter
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/ses"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const (
+	// Default maximum amount of time to wait for an Identity to be verified
+	IdentityVerifiedTimeout = 45 * time.Minute
+
+	// Smallest time to wait between Identity verification status checks
+	IdentityVerificationMinTimeout = 10 * time.Second
+)
+
+// IdentityVerified waits for an Identity to return Success
+func IdentityVerified(conn *ses.SES, identity string, timeout time.Duration) (*ses.IdentityVerificationAttributes, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ses.VerificationStatusPending},
+		Target:     []string{ses.VerificationStatusSuccess},
+		Refresh:    IdentityVerificationStatus(conn, identity),
+		Timeout:    timeout,
+		MinTimeout: IdentityVerificationMinTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ses.IdentityVerificationAttributes); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,12 @@
+package tfresource
+
+import (
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// NotFound returns true if the error represents a "resource not found" condition.
+// Specifically, NotFound returns true if the error is of type resource.NotFoundError.
+func NotFound(err error) bool {
+	_, ok := err.(*resource.NotFoundError)
+	return ok
+}
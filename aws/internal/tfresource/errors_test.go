@@ -0,0 +1,44 @@
+package tfresource
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestNotFound(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Err      error
+		Expected bool
+	}{
+		{
+			Name: "nil error",
+			Err:  nil,
+		},
+		{
+			Name: "other error",
+			Err:  errors.New("test"),
+		},
+		{
+			Name:     "not found error",
+			Err:      &resource.NotFoundError{LastError: errors.New("test")},
+			Expected: true,
+		},
+		{
+			Name: "timeout error",
+			Err:  &resource.TimeoutError{LastError: errors.New("test")},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := NotFound(testCase.Err)
+
+			if got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
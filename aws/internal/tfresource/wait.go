@@ -0,0 +1,18 @@
+package tfresource
+
+import (
+	"math/rand"
+	"time"
+)
+
+// Jitter returns the given duration randomly adjusted by up to the given
+// fraction in either direction, so that many resources waiting on the same
+// API at once do not poll it in lockstep.
+func Jitter(d time.Duration, fraction float64) time.Duration {
+	if d <= 0 || fraction <= 0 {
+		return d
+	}
+
+	delta := float64(d) * fraction
+	return d + time.Duration(delta*(2*rand.Float64()-1))
+}
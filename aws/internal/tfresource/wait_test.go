@@ -0,0 +1,26 @@
+package tfresource
+
+import (
+	"testing"
+	"time"
+)
+
+func TestJitter(t *testing.T) {
+	d := 10 * time.Second
+
+	for i := 0; i < 100; i++ {
+		got := Jitter(d, 0.25)
+
+		if got < 7500*time.Millisecond || got > 12500*time.Millisecond {
+			t.Fatalf("Jitter(%s, 0.25) = %s, expected value within 25%%", d, got)
+		}
+	}
+
+	if got := Jitter(d, 0); got != d {
+		t.Errorf("Jitter(%s, 0) = %s, expected %s", d, got, d)
+	}
+
+	if got := Jitter(0, 0.25); got != 0 {
+		t.Errorf("Jitter(0, 0.25) = %s, expected 0", got)
+	}
+}
@@ -0,0 +1,100 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+var (
+	kmsKeyArnRegexp   = regexp.MustCompile(`^arn:[\w-]+:kms:[a-z0-9-]+:\d{12}:key/([a-zA-Z0-9-]+)$`)
+	kmsAliasArnRegexp = regexp.MustCompile(`^arn:[\w-]+:kms:[a-z0-9-]+:\d{12}:alias/[a-zA-Z0-9:/_-]+$`)
+)
+
+// isKmsAlias reports whether the given KMS key identifier is an alias name or
+// an alias ARN.
+func isKmsAlias(keyId string) bool {
+	return strings.HasPrefix(keyId, "alias/") || kmsAliasArnRegexp.MatchString(keyId)
+}
+
+// resolveKmsKeyArn returns the ARN of the KMS key referred to by a key ID, key
+// ARN, alias name or alias ARN.
+func resolveKmsKeyArn(conn *kms.KMS, keyId string) (string, error) {
+	if kmsKeyArnRegexp.MatchString(keyId) {
+		return keyId, nil
+	}
+
+	output, err := conn.DescribeKey(&kms.DescribeKeyInput{
+		KeyId: aws.String(keyId),
+	})
+	if err != nil {
+		return "", fmt.Errorf("Error resolving KMS Key (%s): %s", keyId, err)
+	}
+
+	return aws.StringValue(output.KeyMetadata.Arn), nil
+}
+
+// kmsKeyIdStateValue returns the value to save to state for a KMS key
+// attribute, given the configured value and the key ARN reported by AWS.
+// Configured aliases are kept, so that pointing an alias at a new key does
+// not produce a diff, as are key IDs matching the reported ARN.
+func kmsKeyIdStateValue(configured, actual string) string {
+	if configured == "" || actual == "" || configured == actual {
+		return actual
+	}
+
+	if isKmsAlias(configured) {
+		return configured
+	}
+
+	if m := kmsKeyArnRegexp.FindStringSubmatch(actual); m != nil && m[1] == configured {
+		return configured
+	}
+
+	return actual
+}
+
+// customizeDiffKmsKeyId returns a CustomizeDiffFunc that removes the diff of
+// the given computed KMS key attribute when the old and new values refer to
+// the same key, e.g. when a key ARN is replaced by an alias of that key.
+func customizeDiffKmsKeyId(key string) schema.CustomizeDiffFunc {
+	return func(diff *schema.ResourceDiff, meta interface{}) error {
+		if diff.Id() == "" || !diff.HasChange(key) {
+			return nil
+		}
+
+		o, n := diff.GetChange(key)
+		old, new := o.(string), n.(string)
+		if old == "" || new == "" || new == config.UnknownVariableValue {
+			return nil
+		}
+
+		conn := meta.(*AWSClient).kmsconn
+
+		// The alias may not exist until it is created in this apply, in
+		// which case the values are left to differ.
+		oldArn, err := resolveKmsKeyArn(conn, old)
+		if err != nil {
+			log.Printf("[DEBUG] %s", err)
+			return nil
+		}
+		newArn, err := resolveKmsKeyArn(conn, new)
+		if err != nil {
+			log.Printf("[DEBUG] %s", err)
+			return nil
+		}
+
+		if oldArn == newArn {
+			log.Printf("[DEBUG] %q and %q refer to the same KMS Key (%s), ignoring diff of %s", old, new, newArn, key)
+			return diff.Clear(key)
+		}
+
+		return nil
+	}
+}
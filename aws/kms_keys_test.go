@@ -0,0 +1,49 @@
+package aws
+
+import (
+	"testing"
+)
+
+func TestIsKmsAlias(t *testing.T) {
+	cases := []struct {
+		keyId string
+		alias bool
+	}{
+		{"alias/tf-acc-test", true},
+		{"alias/aws/s3", true},
+		{"arn:aws:kms:us-west-2:123456789012:alias/tf-acc-test", true},
+		{"arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab", false},
+		{"1234abcd-12ab-34cd-56ef-1234567890ab", false},
+	}
+
+	for _, tc := range cases {
+		if got := isKmsAlias(tc.keyId); got != tc.alias {
+			t.Errorf("Expected isKmsAlias to return %t for %q", tc.alias, tc.keyId)
+		}
+	}
+}
+
+func TestKmsKeyIdStateValue(t *testing.T) {
+	keyArn := "arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"
+
+	cases := []struct {
+		configured string
+		actual     string
+		expected   string
+	}{
+		{"", keyArn, keyArn},
+		{keyArn, keyArn, keyArn},
+		{"alias/tf-acc-test", keyArn, "alias/tf-acc-test"},
+		{"arn:aws:kms:us-west-2:123456789012:alias/tf-acc-test", keyArn, "arn:aws:kms:us-west-2:123456789012:alias/tf-acc-test"},
+		{"1234abcd-12ab-34cd-56ef-1234567890ab", keyArn, "1234abcd-12ab-34cd-56ef-1234567890ab"},
+		{"5678efgh-12ab-34cd-56ef-1234567890ab", keyArn, keyArn},
+		{"arn:aws:kms:us-west-2:123456789012:key/5678efgh-12ab-34cd-56ef-1234567890ab", keyArn, keyArn},
+		{"alias/tf-acc-test", "", ""},
+	}
+
+	for _, tc := range cases {
+		if got := kmsKeyIdStateValue(tc.configured, tc.actual); got != tc.expected {
+			t.Errorf("kmsKeyIdStateValue(%q, %q) => %q, want %q", tc.configured, tc.actual, got, tc.expected)
+		}
+	}
+}
@@ -0,0 +1,132 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// stateMigrationFunc upgrades instance state by a single schema version.
+type stateMigrationFunc func(*terraform.InstanceState) (*terraform.InstanceState, error)
+
+// migrateStateChain returns a schema.StateMigrateFunc which applies the given
+// migrations in turn, starting from the version of the state being migrated:
+// migrations[0] upgrades v0 to v1, migrations[1] upgrades v1 to v2 and so on.
+// The resource's SchemaVersion must equal len(migrations).
+//
+// Empty state is returned as is, and attributes are logged before and after
+// each migration, so the migrations only need to handle the attributes.
+func migrateStateChain(name string, migrations ...stateMigrationFunc) schema.StateMigrateFunc {
+	return func(v int, is *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, error) {
+		if v < 0 || v >= len(migrations) {
+			return is, fmt.Errorf("Unexpected schema version: %d", v)
+		}
+
+		for ; v < len(migrations); v++ {
+			log.Printf("[INFO] Found %s State v%d; migrating to v%d", name, v, v+1)
+
+			if is.Empty() || is.Attributes == nil {
+				log.Printf("[DEBUG] Empty %s State; nothing to migrate.", name)
+				return is, nil
+			}
+
+			log.Printf("[DEBUG] Attributes before migration: %#v", is.Attributes)
+
+			var err error
+			is, err = migrations[v](is)
+			if err != nil {
+				return is, err
+			}
+
+			log.Printf("[DEBUG] Attributes after migration: %#v, id: %s", is.Attributes, is.ID)
+		}
+
+		return is, nil
+	}
+}
+
+// migrateStateSetId changes the ID of the instance state, for resources whose
+// ID format has changed.
+func migrateStateSetId(is *terraform.InstanceState, id string) {
+	is.ID = id
+	is.Attributes["id"] = id
+}
+
+// migrateStateRenameAttribute renames a flatmapped attribute, including all
+// of its nested keys if it is a list, set, map or block.
+func migrateStateRenameAttribute(attributes map[string]string, oldName, newName string) {
+	prefix := oldName + "."
+	for k, v := range attributes {
+		if k == oldName {
+			delete(attributes, k)
+			attributes[newName] = v
+		} else if strings.HasPrefix(k, prefix) {
+			delete(attributes, k)
+			attributes[newName+"."+strings.TrimPrefix(k, prefix)] = v
+		}
+	}
+}
+
+// migrateStateRemoveAttribute removes a flatmapped attribute, including all
+// of its nested keys if it is a list, set, map or block.
+func migrateStateRemoveAttribute(attributes map[string]string, name string) {
+	prefix := name + "."
+	for k := range attributes {
+		if k == name || strings.HasPrefix(k, prefix) {
+			delete(attributes, k)
+		}
+	}
+}
+
+// migrateStateAttributeToBlock moves a top-level attribute into a single
+// element list block, e.g. weight into weighted_routing_policy.0.weight.
+// Nothing is done if the attribute is not set.
+func migrateStateAttributeToBlock(attributes map[string]string, name, blockName, blockAttributeName string) {
+	v, ok := attributes[name]
+	if !ok {
+		return
+	}
+
+	delete(attributes, name)
+	attributes[blockName+".#"] = "1"
+	attributes[fmt.Sprintf("%s.0.%s", blockName, blockAttributeName)] = v
+}
+
+// migrateStateMapToBlocks converts a flatmapped TypeMap attribute into a list
+// of blocks with the given key and value attributes, ordered by map key, e.g.
+// tags.Name = foo into tag.0.key = Name and tag.0.value = foo. Nothing is done
+// if the map is not set.
+func migrateStateMapToBlocks(attributes map[string]string, mapName, blockName, keyName, valueName string) {
+	if _, ok := attributes[mapName+".%"]; !ok {
+		return
+	}
+
+	prefix := mapName + "."
+	m := make(map[string]string)
+	for k, v := range attributes {
+		if !strings.HasPrefix(k, prefix) {
+			continue
+		}
+		delete(attributes, k)
+		if key := strings.TrimPrefix(k, prefix); key != "%" {
+			m[key] = v
+		}
+	}
+
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	attributes[blockName+".#"] = strconv.Itoa(len(keys))
+	for i, k := range keys {
+		attributes[fmt.Sprintf("%s.%d.%s", blockName, i, keyName)] = k
+		attributes[fmt.Sprintf("%s.%d.%s", blockName, i, valueName)] = m[k]
+	}
+}
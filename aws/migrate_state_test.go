@@ -0,0 +1,167 @@
+package aws
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// stateMigrationTestCase describes the state expected after migrating state of
+// the given schema version. ExpectedID is only checked when set.
+type stateMigrationTestCase struct {
+	StateVersion int
+	ID           string
+	Attributes   map[string]string
+	ExpectedID   string
+	Expected     map[string]string
+}
+
+// testStateMigration runs each test case through a resource's MigrateState
+// function and compares all of the resulting attributes with the expected ones.
+func testStateMigration(t *testing.T, migrate schema.StateMigrateFunc, cases map[string]stateMigrationTestCase) {
+	for tn, tc := range cases {
+		is := &terraform.InstanceState{
+			ID:         tc.ID,
+			Attributes: tc.Attributes,
+		}
+
+		is, err := migrate(tc.StateVersion, is, nil)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", tn, err)
+			continue
+		}
+
+		if tc.ExpectedID != "" && is.ID != tc.ExpectedID {
+			t.Errorf("%s: expected ID %q, got %q", tn, tc.ExpectedID, is.ID)
+		}
+		if !reflect.DeepEqual(is.Attributes, tc.Expected) {
+			t.Errorf("%s: expected attributes:\n%#v\ngot:\n%#v", tn, tc.Expected, is.Attributes)
+		}
+	}
+}
+
+func TestMigrateStateChain(t *testing.T) {
+	migrate := migrateStateChain("Test",
+		func(is *terraform.InstanceState) (*terraform.InstanceState, error) {
+			is.Attributes["steps"] += "0"
+			return is, nil
+		},
+		func(is *terraform.InstanceState) (*terraform.InstanceState, error) {
+			is.Attributes["steps"] += "1"
+			migrateStateSetId(is, "new-id")
+			return is, nil
+		},
+	)
+
+	testStateMigration(t, migrate, map[string]stateMigrationTestCase{
+		"v0": {
+			StateVersion: 0,
+			ID:           "old-id",
+			Attributes:   map[string]string{"steps": ""},
+			ExpectedID:   "new-id",
+			Expected:     map[string]string{"steps": "01", "id": "new-id"},
+		},
+		"v1": {
+			StateVersion: 1,
+			ID:           "old-id",
+			Attributes:   map[string]string{"steps": ""},
+			ExpectedID:   "new-id",
+			Expected:     map[string]string{"steps": "1", "id": "new-id"},
+		},
+	})
+
+	empty := &terraform.InstanceState{}
+	if is, err := migrate(0, empty, nil); err != nil || is != empty {
+		t.Fatalf("Expected empty state to be returned as is, got %#v, %s", is, err)
+	}
+
+	for _, v := range []int{-1, 2} {
+		if _, err := migrate(v, &terraform.InstanceState{ID: "id"}, nil); err == nil {
+			t.Fatalf("Expected an error migrating schema version %d", v)
+		}
+	}
+}
+
+func TestMigrateStateRenameAttribute(t *testing.T) {
+	attributes := map[string]string{
+		"name":         "foo",
+		"name_prefix":  "bar",
+		"rules.#":      "1",
+		"rules.0.port": "80",
+	}
+
+	migrateStateRenameAttribute(attributes, "name", "display_name")
+	migrateStateRenameAttribute(attributes, "rules", "rule")
+
+	expected := map[string]string{
+		"display_name": "foo",
+		"name_prefix":  "bar",
+		"rule.#":       "1",
+		"rule.0.port":  "80",
+	}
+	if !reflect.DeepEqual(attributes, expected) {
+		t.Fatalf("Expected %#v, got %#v", expected, attributes)
+	}
+}
+
+func TestMigrateStateRemoveAttribute(t *testing.T) {
+	attributes := map[string]string{
+		"name":         "foo",
+		"name_prefix":  "bar",
+		"rules.#":      "1",
+		"rules.0.port": "80",
+	}
+
+	migrateStateRemoveAttribute(attributes, "name")
+	migrateStateRemoveAttribute(attributes, "rules")
+
+	expected := map[string]string{
+		"name_prefix": "bar",
+	}
+	if !reflect.DeepEqual(attributes, expected) {
+		t.Fatalf("Expected %#v, got %#v", expected, attributes)
+	}
+}
+
+func TestMigrateStateAttributeToBlock(t *testing.T) {
+	attributes := map[string]string{
+		"weight": "10",
+	}
+
+	migrateStateAttributeToBlock(attributes, "weight", "weighted_routing_policy", "weight")
+	migrateStateAttributeToBlock(attributes, "failover", "failover_routing_policy", "type")
+
+	expected := map[string]string{
+		"weighted_routing_policy.#":        "1",
+		"weighted_routing_policy.0.weight": "10",
+	}
+	if !reflect.DeepEqual(attributes, expected) {
+		t.Fatalf("Expected %#v, got %#v", expected, attributes)
+	}
+}
+
+func TestMigrateStateMapToBlocks(t *testing.T) {
+	attributes := map[string]string{
+		"tags.%":     "2",
+		"tags.Name":  "foo",
+		"tags.Owner": "bar",
+		"tags_all":   "baz",
+	}
+
+	migrateStateMapToBlocks(attributes, "tags", "tag", "key", "value")
+	migrateStateMapToBlocks(attributes, "labels", "label", "key", "value")
+
+	expected := map[string]string{
+		"tag.#":       "2",
+		"tag.0.key":   "Name",
+		"tag.0.value": "foo",
+		"tag.1.key":   "Owner",
+		"tag.1.value": "bar",
+		"tags_all":    "baz",
+	}
+	if !reflect.DeepEqual(attributes, expected) {
+		t.Fatalf("Expected %#v, got %#v", expected, attributes)
+	}
+}
@@ -0,0 +1,56 @@
+package aws
+
+import (
+	"fmt"
+)
+
+// mutationLimiter bounds the number of create, update and delete requests in
+// flight against APIs with strict concurrency limits, as configured by the
+// provider's max_concurrent_mutations argument. A nil limiter is unbounded.
+type mutationLimiter chan struct{}
+
+func newMutationLimiter(n int) mutationLimiter {
+	if n <= 0 {
+		return nil
+	}
+	return make(mutationLimiter, n)
+}
+
+func (l mutationLimiter) acquire() {
+	if l != nil {
+		l <- struct{}{}
+	}
+}
+
+func (l mutationLimiter) release() {
+	if l != nil {
+		<-l
+	}
+}
+
+// lockMutation serializes mutations sharing the given key, then waits for a
+// free mutation slot. Call the returned function to release both.
+//
+// The key is locked first so that callers queued behind another mutation of
+// the same key don't hold a slot while they wait.
+func lockMutation(meta interface{}, key string) func() {
+	awsMutexKV.Lock(key)
+	limiter := meta.(*AWSClient).mutationLimiter
+	limiter.acquire()
+
+	return func() {
+		limiter.release()
+		awsMutexKV.Unlock(key)
+	}
+}
+
+// Mutex keys for APIs which reject concurrent mutations, used with lockMutation.
+const awsMutexCloudFrontDistributionKey = `aws_cloudfront_distribution`
+
+func awsMutexElasticBeanstalkApplicationKey(application string) string {
+	return fmt.Sprintf("aws_elastic_beanstalk_application-%s", application)
+}
+
+func awsMutexRoute53HostedZoneKey(zoneId string) string {
+	return fmt.Sprintf("aws_route53_zone-%s", zoneId)
+}
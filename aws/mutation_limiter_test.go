@@ -0,0 +1,77 @@
+package aws
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestMutationLimiter(t *testing.T) {
+	cases := []struct {
+		Limit    int
+		Expected int32
+	}{
+		{Limit: 0, Expected: 5},
+		{Limit: 1, Expected: 1},
+		{Limit: 2, Expected: 2},
+	}
+
+	for _, tc := range cases {
+		meta := &AWSClient{mutationLimiter: newMutationLimiter(tc.Limit)}
+
+		var wg sync.WaitGroup
+		var inFlight, maxInFlight int32
+		for i := 0; i < 5; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+
+				// Distinct keys, so only the limiter bounds concurrency.
+				unlock := lockMutation(meta, string(rune('a'+i)))
+				defer unlock()
+
+				n := atomic.AddInt32(&inFlight, 1)
+				for {
+					max := atomic.LoadInt32(&maxInFlight)
+					if n <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, n) {
+						break
+					}
+				}
+				time.Sleep(50 * time.Millisecond)
+				atomic.AddInt32(&inFlight, -1)
+			}(i)
+		}
+		wg.Wait()
+
+		if maxInFlight != tc.Expected {
+			t.Errorf("limit %d: expected %d mutations in flight, got %d", tc.Limit, tc.Expected, maxInFlight)
+		}
+	}
+}
+
+func TestLockMutation_sameKey(t *testing.T) {
+	meta := &AWSClient{}
+
+	unlock := lockMutation(meta, "key")
+
+	locked := make(chan struct{})
+	go func() {
+		defer lockMutation(meta, "key")()
+		close(locked)
+	}()
+
+	select {
+	case <-locked:
+		t.Fatal("Expected mutations of the same key to be serialized")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	unlock()
+
+	select {
+	case <-locked:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Expected mutation to proceed after unlock")
+	}
+}
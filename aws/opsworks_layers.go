@@ -106,7 +106,7 @@ func (lt *opsworksLayerType) SchemaResource() *schema.Resource {
 
 		"custom_json": &schema.Schema{
 			Type:      schema.TypeString,
-			StateFunc: normalizeJson,
+			StateFunc: normalizeJsonStateFunc,
 			Optional:  true,
 		},
 
@@ -296,7 +296,7 @@ func (lt *opsworksLayerType) Read(d *schema.ResourceData, client *opsworks.OpsWo
 		if err := d.Set("custom_json", ""); err != nil {
 			return err
 		}
-	} else if err := d.Set("custom_json", normalizeJson(*v)); err != nil {
+	} else if err := d.Set("custom_json", normalizeJsonStateFunc(*v)); err != nil {
 		return err
 	}
 
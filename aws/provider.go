@@ -75,6 +75,13 @@ func Provider() terraform.ResourceProvider {
 				Description: descriptions["max_retries"],
 			},
 
+			"max_concurrent_mutations": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: descriptions["max_concurrent_mutations"],
+			},
+
 			"allowed_account_ids": {
 				Type:          schema.TypeSet,
 				Elem:          &schema.Schema{Type: schema.TypeString},
@@ -160,72 +167,78 @@ func Provider() terraform.ResourceProvider {
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"aws_acm_certificate":            dataSourceAwsAcmCertificate(),
-			"aws_ami":                        dataSourceAwsAmi(),
-			"aws_ami_ids":                    dataSourceAwsAmiIds(),
-			"aws_autoscaling_groups":         dataSourceAwsAutoscalingGroups(),
-			"aws_availability_zone":          dataSourceAwsAvailabilityZone(),
-			"aws_availability_zones":         dataSourceAwsAvailabilityZones(),
-			"aws_billing_service_account":    dataSourceAwsBillingServiceAccount(),
-			"aws_caller_identity":            dataSourceAwsCallerIdentity(),
-			"aws_canonical_user_id":          dataSourceAwsCanonicalUserId(),
-			"aws_cloudformation_stack":       dataSourceAwsCloudFormationStack(),
-			"aws_cloudtrail_service_account": dataSourceAwsCloudTrailServiceAccount(),
-			"aws_db_instance":                dataSourceAwsDbInstance(),
-			"aws_db_snapshot":                dataSourceAwsDbSnapshot(),
-			"aws_dynamodb_table":             dataSourceAwsDynamoDbTable(),
-			"aws_ebs_snapshot":               dataSourceAwsEbsSnapshot(),
-			"aws_ebs_snapshot_ids":           dataSourceAwsEbsSnapshotIds(),
-			"aws_ebs_volume":                 dataSourceAwsEbsVolume(),
-			"aws_ecr_repository":             dataSourceAwsEcrRepository(),
-			"aws_ecs_cluster":                dataSourceAwsEcsCluster(),
-			"aws_ecs_container_definition":   dataSourceAwsEcsContainerDefinition(),
-			"aws_ecs_task_definition":        dataSourceAwsEcsTaskDefinition(),
-			"aws_efs_file_system":            dataSourceAwsEfsFileSystem(),
-			"aws_efs_mount_target":           dataSourceAwsEfsMountTarget(),
-			"aws_eip":                        dataSourceAwsEip(),
-			"aws_elastic_beanstalk_solution_stack": dataSourceAwsElasticBeanstalkSolutionStack(),
-			"aws_elasticache_cluster":              dataSourceAwsElastiCacheCluster(),
-			"aws_elb":                              dataSourceAwsElb(),
-			"aws_elasticache_replication_group":    dataSourceAwsElasticacheReplicationGroup(),
-			"aws_elb_hosted_zone_id":               dataSourceAwsElbHostedZoneId(),
-			"aws_elb_service_account":              dataSourceAwsElbServiceAccount(),
-			"aws_iam_account_alias":                dataSourceAwsIamAccountAlias(),
-			"aws_iam_group":                        dataSourceAwsIAMGroup(),
-			"aws_iam_instance_profile":             dataSourceAwsIAMInstanceProfile(),
-			"aws_iam_policy_document":              dataSourceAwsIamPolicyDocument(),
-			"aws_iam_role":                         dataSourceAwsIAMRole(),
-			"aws_iam_server_certificate":           dataSourceAwsIAMServerCertificate(),
-			"aws_iam_user":                         dataSourceAwsIAMUser(),
-			"aws_internet_gateway":                 dataSourceAwsInternetGateway(),
-			"aws_instance":                         dataSourceAwsInstance(),
-			"aws_instances":                        dataSourceAwsInstances(),
-			"aws_ip_ranges":                        dataSourceAwsIPRanges(),
-			"aws_kinesis_stream":                   dataSourceAwsKinesisStream(),
-			"aws_kms_alias":                        dataSourceAwsKmsAlias(),
-			"aws_kms_ciphertext":                   dataSourceAwsKmsCiphertext(),
-			"aws_kms_secret":                       dataSourceAwsKmsSecret(),
-			"aws_nat_gateway":                      dataSourceAwsNatGateway(),
-			"aws_network_interface":                dataSourceAwsNetworkInterface(),
-			"aws_partition":                        dataSourceAwsPartition(),
-			"aws_prefix_list":                      dataSourceAwsPrefixList(),
-			"aws_rds_cluster":                      dataSourceAwsRdsCluster(),
-			"aws_redshift_service_account":         dataSourceAwsRedshiftServiceAccount(),
-			"aws_region":                           dataSourceAwsRegion(),
-			"aws_route_table":                      dataSourceAwsRouteTable(),
-			"aws_route53_zone":                     dataSourceAwsRoute53Zone(),
-			"aws_s3_bucket":                        dataSourceAwsS3Bucket(),
-			"aws_s3_bucket_object":                 dataSourceAwsS3BucketObject(),
-			"aws_sns_topic":                        dataSourceAwsSnsTopic(),
-			"aws_ssm_parameter":                    dataSourceAwsSsmParameter(),
-			"aws_subnet":                           dataSourceAwsSubnet(),
-			"aws_subnet_ids":                       dataSourceAwsSubnetIDs(),
-			"aws_security_group":                   dataSourceAwsSecurityGroup(),
-			"aws_vpc":                              dataSourceAwsVpc(),
-			"aws_vpc_endpoint":                     dataSourceAwsVpcEndpoint(),
-			"aws_vpc_endpoint_service":             dataSourceAwsVpcEndpointService(),
-			"aws_vpc_peering_connection":           dataSourceAwsVpcPeeringConnection(),
-			"aws_vpn_gateway":                      dataSourceAwsVpnGateway(),
+			"aws_acm_certificate":                             dataSourceAwsAcmCertificate(),
+			"aws_ami":                                         dataSourceAwsAmi(),
+			"aws_ami_ids":                                     dataSourceAwsAmiIds(),
+			"aws_autoscaling_groups":                          dataSourceAwsAutoscalingGroups(),
+			"aws_availability_zone":                           dataSourceAwsAvailabilityZone(),
+			"aws_availability_zones":                          dataSourceAwsAvailabilityZones(),
+			"aws_billing_service_account":                     dataSourceAwsBillingServiceAccount(),
+			"aws_caller_identity":                             dataSourceAwsCallerIdentity(),
+			"aws_canonical_user_id":                           dataSourceAwsCanonicalUserId(),
+			"aws_cloudfront_log_delivery_canonical_user_id":   dataSourceAwsCloudFrontLogDeliveryCanonicalUserId(),
+			"aws_cloudformation_stack":                        dataSourceAwsCloudFormationStack(),
+			"aws_cloudtrail_service_account":                  dataSourceAwsCloudTrailServiceAccount(),
+			"aws_db_instance":                                 dataSourceAwsDbInstance(),
+			"aws_db_snapshot":                                 dataSourceAwsDbSnapshot(),
+			"aws_dynamodb_table":                              dataSourceAwsDynamoDbTable(),
+			"aws_ebs_snapshot":                                dataSourceAwsEbsSnapshot(),
+			"aws_ebs_snapshot_ids":                            dataSourceAwsEbsSnapshotIds(),
+			"aws_ebs_volume":                                  dataSourceAwsEbsVolume(),
+			"aws_ecr_repository":                              dataSourceAwsEcrRepository(),
+			"aws_ecs_cluster":                                 dataSourceAwsEcsCluster(),
+			"aws_ecs_container_definition":                    dataSourceAwsEcsContainerDefinition(),
+			"aws_ecs_task_definition":                         dataSourceAwsEcsTaskDefinition(),
+			"aws_efs_file_system":                             dataSourceAwsEfsFileSystem(),
+			"aws_efs_mount_target":                            dataSourceAwsEfsMountTarget(),
+			"aws_eip":                                         dataSourceAwsEip(),
+			"aws_elastic_beanstalk_environment_load_balancer": dataSourceAwsElasticBeanstalkEnvironmentLoadBalancer(),
+			"aws_elastic_beanstalk_solution_stack":            dataSourceAwsElasticBeanstalkSolutionStack(),
+			"aws_elasticache_cluster":                         dataSourceAwsElastiCacheCluster(),
+			"aws_elb":                                         dataSourceAwsElb(),
+			"aws_elasticache_replication_group":               dataSourceAwsElasticacheReplicationGroup(),
+			"aws_elb_hosted_zone_id":                          dataSourceAwsElbHostedZoneId(),
+			"aws_elb_service_account":                         dataSourceAwsElbServiceAccount(),
+			"aws_iam_account_alias":                           dataSourceAwsIamAccountAlias(),
+			"aws_iam_group":                                   dataSourceAwsIAMGroup(),
+			"aws_iam_instance_profile":                        dataSourceAwsIAMInstanceProfile(),
+			"aws_iam_policy_document":                         dataSourceAwsIamPolicyDocument(),
+			"aws_iam_role":                                    dataSourceAwsIAMRole(),
+			"aws_iam_server_certificate":                      dataSourceAwsIAMServerCertificate(),
+			"aws_iam_user":                                    dataSourceAwsIAMUser(),
+			"aws_internet_gateway":                            dataSourceAwsInternetGateway(),
+			"aws_instance":                                    dataSourceAwsInstance(),
+			"aws_instances":                                   dataSourceAwsInstances(),
+			"aws_ip_ranges":                                   dataSourceAwsIPRanges(),
+			"aws_kinesis_stream":                              dataSourceAwsKinesisStream(),
+			"aws_kms_alias":                                   dataSourceAwsKmsAlias(),
+			"aws_kms_ciphertext":                              dataSourceAwsKmsCiphertext(),
+			"aws_kms_secret":                                  dataSourceAwsKmsSecret(),
+			"aws_launch_template":                             dataSourceAwsLaunchTemplate(),
+			"aws_media_convert_endpoint":                      dataSourceAwsMediaConvertEndpoint(),
+			"aws_nat_gateway":                                 dataSourceAwsNatGateway(),
+			"aws_network_interface":                           dataSourceAwsNetworkInterface(),
+			"aws_partition":                                   dataSourceAwsPartition(),
+			"aws_prefix_list":                                 dataSourceAwsPrefixList(),
+			"aws_rds_cluster":                                 dataSourceAwsRdsCluster(),
+			"aws_redshift_service_account":                    dataSourceAwsRedshiftServiceAccount(),
+			"aws_region":                                      dataSourceAwsRegion(),
+			"aws_route_table":                                 dataSourceAwsRouteTable(),
+			"aws_route53_zone":                                dataSourceAwsRoute53Zone(),
+			"aws_s3_bucket":                                   dataSourceAwsS3Bucket(),
+			"aws_s3_bucket_object":                            dataSourceAwsS3BucketObject(),
+			"aws_sfn_state_machine":                           dataSourceAwsSfnStateMachine(),
+			"aws_sns_topic":                                   dataSourceAwsSnsTopic(),
+			"aws_ssm_parameter":                               dataSourceAwsSsmParameter(),
+			"aws_sts_session_token":                           dataSourceAwsStsSessionToken(),
+			"aws_subnet":                                      dataSourceAwsSubnet(),
+			"aws_subnet_ids":                                  dataSourceAwsSubnetIDs(),
+			"aws_security_group":                              dataSourceAwsSecurityGroup(),
+			"aws_vpc":                                         dataSourceAwsVpc(),
+			"aws_vpc_endpoint":                                dataSourceAwsVpcEndpoint(),
+			"aws_vpc_endpoint_service":                        dataSourceAwsVpcEndpointService(),
+			"aws_vpc_peering_connection":                      dataSourceAwsVpcPeeringConnection(),
+			"aws_vpn_gateway":                                 dataSourceAwsVpnGateway(),
 
 			// Adding the Aliases for the ALB -> LB Rename
 			"aws_lb":               dataSourceAwsLb(),
@@ -237,6 +250,8 @@ func Provider() terraform.ResourceProvider {
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
+			"aws_acm_certificate":                          resourceAwsAcmCertificate(),
+			"aws_acm_certificate_validation":               resourceAwsAcmCertificateValidation(),
 			"aws_ami":                                      resourceAwsAmi(),
 			"aws_ami_copy":                                 resourceAwsAmiCopy(),
 			"aws_ami_from_instance":                        resourceAwsAmiFromInstance(),
@@ -276,6 +291,7 @@ func Provider() terraform.ResourceProvider {
 			"aws_cloudfront_distribution":                  resourceAwsCloudFrontDistribution(),
 			"aws_cloudfront_origin_access_identity":        resourceAwsCloudFrontOriginAccessIdentity(),
 			"aws_cloudtrail":                               resourceAwsCloudTrail(),
+			"aws_cloudwatch_event_permission":              resourceAwsCloudWatchEventPermission(),
 			"aws_cloudwatch_event_rule":                    resourceAwsCloudWatchEventRule(),
 			"aws_cloudwatch_event_target":                  resourceAwsCloudWatchEventTarget(),
 			"aws_cloudwatch_log_destination":               resourceAwsCloudWatchLogDestination(),
@@ -289,9 +305,13 @@ func Provider() terraform.ResourceProvider {
 			"aws_config_configuration_recorder":            resourceAwsConfigConfigurationRecorder(),
 			"aws_config_configuration_recorder_status":     resourceAwsConfigConfigurationRecorderStatus(),
 			"aws_config_delivery_channel":                  resourceAwsConfigDeliveryChannel(),
+			"aws_cognito_identity_provider":                resourceAwsCognitoIdentityProvider(),
 			"aws_cognito_identity_pool":                    resourceAwsCognitoIdentityPool(),
 			"aws_cognito_identity_pool_roles_attachment":   resourceAwsCognitoIdentityPoolRolesAttachment(),
+			"aws_cognito_resource_server":                  resourceAwsCognitoResourceServer(),
 			"aws_cognito_user_pool":                        resourceAwsCognitoUserPool(),
+			"aws_cognito_user_pool_client":                 resourceAwsCognitoUserPoolClient(),
+			"aws_cognito_user_pool_domain":                 resourceAwsCognitoUserPoolDomain(),
 			"aws_autoscaling_lifecycle_hook":               resourceAwsAutoscalingLifecycleHook(),
 			"aws_cloudwatch_metric_alarm":                  resourceAwsCloudWatchMetricAlarm(),
 			"aws_cloudwatch_dashboard":                     resourceAwsCloudWatchDashboard(),
@@ -354,6 +374,12 @@ func Provider() terraform.ResourceProvider {
 			"aws_emr_security_configuration":               resourceAwsEMRSecurityConfiguration(),
 			"aws_flow_log":                                 resourceAwsFlowLog(),
 			"aws_glacier_vault":                            resourceAwsGlacierVault(),
+			"aws_glue_catalog_database":                    resourceAwsGlueCatalogDatabase(),
+			"aws_glue_catalog_table":                       resourceAwsGlueCatalogTable(),
+			"aws_glue_classifier":                          resourceAwsGlueClassifier(),
+			"aws_glue_crawler":                             resourceAwsGlueCrawler(),
+			"aws_glue_job":                                 resourceAwsGlueJob(),
+			"aws_glue_trigger":                             resourceAwsGlueTrigger(),
 			"aws_iam_access_key":                           resourceAwsIamAccessKey(),
 			"aws_iam_account_alias":                        resourceAwsIamAccountAlias(),
 			"aws_iam_account_password_policy":              resourceAwsIamAccountPasswordPolicy(),
@@ -386,12 +412,14 @@ func Provider() terraform.ResourceProvider {
 			"aws_kinesis_firehose_delivery_stream":         resourceAwsKinesisFirehoseDeliveryStream(),
 			"aws_kinesis_stream":                           resourceAwsKinesisStream(),
 			"aws_kms_alias":                                resourceAwsKmsAlias(),
+			"aws_kms_grant":                                resourceAwsKmsGrant(),
 			"aws_kms_key":                                  resourceAwsKmsKey(),
 			"aws_lambda_function":                          resourceAwsLambdaFunction(),
 			"aws_lambda_event_source_mapping":              resourceAwsLambdaEventSourceMapping(),
 			"aws_lambda_alias":                             resourceAwsLambdaAlias(),
 			"aws_lambda_permission":                        resourceAwsLambdaPermission(),
 			"aws_launch_configuration":                     resourceAwsLaunchConfiguration(),
+			"aws_launch_template":                          resourceAwsLaunchTemplate(),
 			"aws_lightsail_domain":                         resourceAwsLightsailDomain(),
 			"aws_lightsail_instance":                       resourceAwsLightsailInstance(),
 			"aws_lightsail_key_pair":                       resourceAwsLightsailKeyPair(),
@@ -439,6 +467,8 @@ func Provider() terraform.ResourceProvider {
 			"aws_redshift_subnet_group":                    resourceAwsRedshiftSubnetGroup(),
 			"aws_route53_delegation_set":                   resourceAwsRoute53DelegationSet(),
 			"aws_route53_record":                           resourceAwsRoute53Record(),
+			"aws_route53_traffic_policy":                   resourceAwsRoute53TrafficPolicy(),
+			"aws_route53_traffic_policy_instance":          resourceAwsRoute53TrafficPolicyInstance(),
 			"aws_route53_zone_association":                 resourceAwsRoute53ZoneAssociation(),
 			"aws_route53_zone":                             resourceAwsRoute53Zone(),
 			"aws_route53_health_check":                     resourceAwsRoute53HealthCheck(),
@@ -448,6 +478,7 @@ func Provider() terraform.ResourceProvider {
 			"aws_route_table_association":                  resourceAwsRouteTableAssociation(),
 			"aws_ses_active_receipt_rule_set":              resourceAwsSesActiveReceiptRuleSet(),
 			"aws_ses_domain_identity":                      resourceAwsSesDomainIdentity(),
+			"aws_ses_domain_identity_verification":         resourceAwsSesDomainIdentityVerification(),
 			"aws_ses_domain_dkim":                          resourceAwsSesDomainDkim(),
 			"aws_ses_receipt_filter":                       resourceAwsSesReceiptFilter(),
 			"aws_ses_receipt_rule":                         resourceAwsSesReceiptRule(),
@@ -459,11 +490,15 @@ func Provider() terraform.ResourceProvider {
 			"aws_s3_bucket_policy":                         resourceAwsS3BucketPolicy(),
 			"aws_s3_bucket_object":                         resourceAwsS3BucketObject(),
 			"aws_s3_bucket_notification":                   resourceAwsS3BucketNotification(),
+			"aws_s3_bucket_replication_configuration":      resourceAwsS3BucketReplicationConfiguration(),
 			"aws_security_group":                           resourceAwsSecurityGroup(),
 			"aws_network_interface_sg_attachment":          resourceAwsNetworkInterfaceSGAttachment(),
 			"aws_default_security_group":                   resourceAwsDefaultSecurityGroup(),
 			"aws_security_group_rule":                      resourceAwsSecurityGroupRule(),
 			"aws_servicecatalog_portfolio":                 resourceAwsServiceCatalogPortfolio(),
+			"aws_service_discovery_private_dns_namespace":  resourceAwsServiceDiscoveryPrivateDnsNamespace(),
+			"aws_service_discovery_public_dns_namespace":   resourceAwsServiceDiscoveryPublicDnsNamespace(),
+			"aws_service_discovery_service":                resourceAwsServiceDiscoveryService(),
 			"aws_simpledb_domain":                          resourceAwsSimpleDBDomain(),
 			"aws_ssm_activation":                           resourceAwsSsmActivation(),
 			"aws_ssm_association":                          resourceAwsSsmAssociation(),
@@ -481,6 +516,8 @@ func Provider() terraform.ResourceProvider {
 			"aws_sqs_queue":                                resourceAwsSqsQueue(),
 			"aws_sqs_queue_policy":                         resourceAwsSqsQueuePolicy(),
 			"aws_snapshot_create_volume_permission":        resourceAwsSnapshotCreateVolumePermission(),
+			"aws_sns_platform_application":                 resourceAwsSnsPlatformApplication(),
+			"aws_sns_sms_preferences":                      resourceAwsSnsSmsPreferences(),
 			"aws_sns_topic":                                resourceAwsSnsTopic(),
 			"aws_sns_topic_policy":                         resourceAwsSnsTopicPolicy(),
 			"aws_sns_topic_subscription":                   resourceAwsSnsTopicSubscription(),
@@ -497,6 +534,7 @@ func Provider() terraform.ResourceProvider {
 			"aws_default_vpc":                              resourceAwsDefaultVpc(),
 			"aws_vpc":                                      resourceAwsVpc(),
 			"aws_vpc_endpoint":                             resourceAwsVpcEndpoint(),
+			"aws_vpc_endpoint_policy":                      resourceAwsVpcEndpointPolicy(),
 			"aws_vpc_endpoint_route_table_association":     resourceAwsVpcEndpointRouteTableAssociation(),
 			"aws_vpn_connection":                           resourceAwsVpnConnection(),
 			"aws_vpn_connection_route":                     resourceAwsVpnConnectionRoute(),
@@ -526,7 +564,9 @@ func Provider() terraform.ResourceProvider {
 			"aws_alb_listener":                resourceAwsLbListener(),
 			"aws_lb_listener":                 resourceAwsLbListener(),
 			"aws_alb_listener_rule":           resourceAwsLbbListenerRule(),
+			"aws_alb_listener_certificate":    resourceAwsLbListenerCertificate(),
 			"aws_lb_listener_rule":            resourceAwsLbbListenerRule(),
+			"aws_lb_listener_certificate":     resourceAwsLbListenerCertificate(),
 			"aws_alb_target_group":            resourceAwsLbTargetGroup(),
 			"aws_lb_target_group":             resourceAwsLbTargetGroup(),
 			"aws_alb_target_group_attachment": resourceAwsLbTargetGroupAttachment(),
@@ -562,6 +602,11 @@ func init() {
 			"being executed. If the API request still fails, an error is\n" +
 			"thrown.",
 
+		"max_concurrent_mutations": "The maximum number of concurrent create, update and delete\n" +
+			"requests made against APIs with strict concurrency limits, such as\n" +
+			"Elastic Beanstalk environment and CloudFront distribution updates.\n" +
+			"Defaults to 0, which means unlimited.",
+
 		"cloudformation_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
 
 		"cloudwatch_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
@@ -639,6 +684,7 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		Token:                   d.Get("token").(string),
 		Region:                  d.Get("region").(string),
 		MaxRetries:              d.Get("max_retries").(int),
+		MaxConcurrentMutations:  d.Get("max_concurrent_mutations").(int),
 		Insecure:                d.Get("insecure").(bool),
 		SkipCredsValidation:     d.Get("skip_credentials_validation").(bool),
 		SkipGetEC2Platforms:     d.Get("skip_get_ec2_platforms").(bool),
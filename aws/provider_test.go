@@ -1,10 +1,17 @@
 package aws
 
 import (
+	"fmt"
 	"log"
 	"os"
+	"strconv"
+	"strings"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
+	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-template/template"
@@ -16,6 +23,19 @@ var testAccProvidersWithTLS map[string]terraform.ResourceProvider
 var testAccProvider *schema.Provider
 var testAccTemplateProvider *schema.Provider
 
+const (
+	// Environment variables configuring the alternate account and region used
+	// by acceptance tests of cross-account and cross-region resources.
+	testAccAlternateProfileEnvVar         = "AWS_ALTERNATE_PROFILE"
+	testAccAlternateAccessKeyIdEnvVar     = "AWS_ALTERNATE_ACCESS_KEY_ID"
+	testAccAlternateSecretAccessKeyEnvVar = "AWS_ALTERNATE_SECRET_ACCESS_KEY"
+	testAccAlternateRegionEnvVar          = "AWS_ALTERNATE_REGION"
+
+	// Provider alias of the alternate account or region in test configurations,
+	// referenced from resources as provider = "aws.alternate".
+	testAccProviderAliasAlternate = "alternate"
+)
+
 func init() {
 	testAccProvider = Provider().(*schema.Provider)
 	testAccTemplateProvider = template.Provider().(*schema.Provider)
@@ -42,6 +62,44 @@ func TestProvider_impl(t *testing.T) {
 	var _ terraform.ResourceProvider = Provider()
 }
 
+func TestProvider_errorCheck(t *testing.T) {
+	mock := &mockTestT{}
+	et := &testAccErrorCheckT{TestT: mock}
+
+	et.Error("Step 0 error: Error creating MediaStore Container: UnknownOperationException: ")
+	if mock.errored || et.skipReason == "" {
+		t.Fatalf("expected an unavailable operation to be recorded as a skip, got error: %t, skip reason: %q", mock.errored, et.skipReason)
+	}
+
+	et.Error("Step 1 error: InvalidParameterValue: bad value")
+	if !mock.errored {
+		t.Fatal("expected other errors to fail the test")
+	}
+
+	et.Fatal("Step 2 error: Error creating MediaStore Container: OptInRequired: ")
+	if mock.fataled || !mock.skipped {
+		t.Fatalf("expected an unavailable service to skip the test, got fatal: %t, skipped: %t", mock.fataled, mock.skipped)
+	}
+
+	// DNS failures are as likely to be network trouble as a missing
+	// endpoint, so they must not hide real failures.
+	et.Fatal("Error configuring provider: dial tcp: lookup sts.us-west-2.amazonaws.com: no such host")
+	if !mock.fataled {
+		t.Fatal("expected a DNS failure to fail the test")
+	}
+}
+
+type mockTestT struct {
+	errored bool
+	fataled bool
+	skipped bool
+}
+
+func (t *mockTestT) Error(args ...interface{}) { t.errored = true }
+func (t *mockTestT) Fatal(args ...interface{}) { t.fataled = true }
+func (t *mockTestT) Skip(args ...interface{})  { t.skipped = true }
+func (t *mockTestT) Name() string              { return "mockTestT" }
+
 func testAccPreCheck(t *testing.T) {
 	if v := os.Getenv("AWS_PROFILE"); v == "" {
 		if v := os.Getenv("AWS_ACCESS_KEY_ID"); v == "" {
@@ -70,3 +128,244 @@ func testAccEC2ClassicPreCheck(t *testing.T) {
 			region, platforms)
 	}
 }
+
+// testAccPartitionPreCheck skips the test unless the provider is configured
+// for the given partition, e.g. for tests asserting partition-specific
+// account IDs or ARNs.
+func testAccPartitionPreCheck(t *testing.T, partition string) {
+	if p := testAccProvider.Meta().(*AWSClient).partition; p != partition {
+		t.Skipf("skipping test; partition (%s) does not equal %s", p, partition)
+	}
+}
+
+// testAccServicePreCheck calls probe, typically an inexpensive List or
+// Describe operation, and skips the test if the error returned shows the
+// service is unavailable in the test region. This avoids failing midway
+// through a test and leaking the resources created so far.
+func testAccServicePreCheck(t *testing.T, service string, probe func() error) {
+	err := probe()
+	if err == nil {
+		return
+	}
+	if testAccErrorCheckSkippable(err.Error()) {
+		t.Skipf("skipping test; %s is unavailable in %s: %s", service, testAccGetRegion(), err)
+	}
+	t.Fatalf("unexpected error checking %s availability: %s", service, err)
+}
+
+// testAccEC2AccountAttributeQuota returns the value of a numeric EC2 account
+// attribute such as vpc-max-elastic-ips.
+func testAccEC2AccountAttributeQuota(t *testing.T, name string) int {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	resp, err := conn.DescribeAccountAttributes(&ec2.DescribeAccountAttributesInput{
+		AttributeNames: []*string{aws.String(name)},
+	})
+	if err != nil {
+		t.Fatalf("error describing EC2 account attribute %s: %s", name, err)
+	}
+
+	for _, attr := range resp.AccountAttributes {
+		if aws.StringValue(attr.AttributeName) != name || len(attr.AttributeValues) == 0 {
+			continue
+		}
+		quota, err := strconv.Atoi(aws.StringValue(attr.AttributeValues[0].AttributeValue))
+		if err != nil {
+			t.Fatalf("error parsing EC2 account attribute %s: %s", name, err)
+		}
+		return quota
+	}
+
+	t.Fatalf("EC2 account attribute %s not found", name)
+	return 0
+}
+
+// testAccEC2VpcElasticIPQuotaPreCheck skips the test if fewer than required
+// VPC Elastic IPs can still be allocated in the test region.
+func testAccEC2VpcElasticIPQuotaPreCheck(t *testing.T, required int) {
+	quota := testAccEC2AccountAttributeQuota(t, "vpc-max-elastic-ips")
+
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+	resp, err := conn.DescribeAddresses(&ec2.DescribeAddressesInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("domain"),
+				Values: []*string{aws.String(ec2.DomainTypeVpc)},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("error describing Elastic IPs: %s", err)
+	}
+
+	if available := quota - len(resp.Addresses); available < required {
+		t.Skipf("skipping test; %d VPC Elastic IPs required but only %d of %d available in %s",
+			required, available, quota, testAccGetRegion())
+	}
+}
+
+// testAccElasticBeanstalkPreCheck skips the test if Elastic Beanstalk is
+// unavailable, rather than leaving environments behind for the sweeper.
+func testAccElasticBeanstalkPreCheck(t *testing.T) {
+	conn := testAccProvider.Meta().(*AWSClient).elasticbeanstalkconn
+
+	testAccServicePreCheck(t, "Elastic Beanstalk", func() error {
+		_, err := conn.DescribeApplications(&elasticbeanstalk.DescribeApplicationsInput{})
+		return err
+	})
+}
+
+// testAccProviderFactories returns provider factories which record every AWS
+// provider initialized for a test, including aliased providers for the
+// alternate account or region, so that checks can look for resources in
+// each of them.
+func testAccProviderFactories(providers *[]*schema.Provider) map[string]terraform.ResourceProviderFactory {
+	return map[string]terraform.ResourceProviderFactory{
+		"aws": func() (terraform.ResourceProvider, error) {
+			p := Provider()
+			*providers = append(*providers, p.(*schema.Provider))
+			return p, nil
+		},
+		"template": terraform.ResourceProviderFactoryFixed(testAccTemplateProvider),
+	}
+}
+
+// testAccCheckWithProviders runs the given check against every configured
+// provider recorded by testAccProviderFactories.
+func testAccCheckWithProviders(f func(*terraform.State, *schema.Provider) error, providers *[]*schema.Provider) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, provider := range *providers {
+			// Ignore if Meta is empty, this can happen for validation providers
+			if provider.Meta() == nil {
+				continue
+			}
+
+			if err := f(s, provider); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func testAccGetRegion() string {
+	if v := os.Getenv("AWS_DEFAULT_REGION"); v != "" {
+		return v
+	}
+	return "us-west-2"
+}
+
+func testAccGetAlternateRegion() string {
+	if v := os.Getenv(testAccAlternateRegionEnvVar); v != "" {
+		return v
+	}
+	return "us-east-1"
+}
+
+func testAccAlternateAccountPreCheck(t *testing.T) {
+	if os.Getenv(testAccAlternateProfileEnvVar) == "" && os.Getenv(testAccAlternateAccessKeyIdEnvVar) == "" {
+		t.Skipf("%s or %s must be set for acceptance tests requiring an alternate account",
+			testAccAlternateProfileEnvVar, testAccAlternateAccessKeyIdEnvVar)
+	}
+
+	if os.Getenv(testAccAlternateAccessKeyIdEnvVar) != "" && os.Getenv(testAccAlternateSecretAccessKeyEnvVar) == "" {
+		t.Fatalf("%s must be set with %s for acceptance tests requiring an alternate account",
+			testAccAlternateSecretAccessKeyEnvVar, testAccAlternateAccessKeyIdEnvVar)
+	}
+}
+
+func testAccAlternateRegionPreCheck(t *testing.T) {
+	if testAccGetRegion() == testAccGetAlternateRegion() {
+		t.Fatalf("%s must be set to a different region than AWS_DEFAULT_REGION (%s) for acceptance tests requiring an alternate region",
+			testAccAlternateRegionEnvVar, testAccGetRegion())
+	}
+}
+
+// testAccAlternateAccountProviderConfig returns the configuration of an
+// aliased provider for the alternate account in the default region.
+func testAccAlternateAccountProviderConfig() string {
+	return fmt.Sprintf(`
+provider "aws" {
+  alias      = %[1]q
+  access_key = %[2]q
+  profile    = %[3]q
+  secret_key = %[4]q
+}
+`, testAccProviderAliasAlternate, os.Getenv(testAccAlternateAccessKeyIdEnvVar), os.Getenv(testAccAlternateProfileEnvVar), os.Getenv(testAccAlternateSecretAccessKeyEnvVar))
+}
+
+// testAccAlternateRegionProviderConfig returns the configuration of an
+// aliased provider for the alternate region in the default account.
+func testAccAlternateRegionProviderConfig() string {
+	return fmt.Sprintf(`
+provider "aws" {
+  alias  = %[1]q
+  region = %[2]q
+}
+`, testAccProviderAliasAlternate, testAccGetAlternateRegion())
+}
+
+// testAccErrorCheckSkipMessages are fragments of errors returned when a
+// service or operation is not available in the region or partition under
+// test, e.g. GovCloud, China or an opt-in region.
+var testAccErrorCheckSkipMessages = []string{
+	"InvalidAction",
+	"OptInRequired",
+	"SubscriptionRequiredException",
+	"UnknownOperationException",
+	"UnsupportedOperation",
+	"is not available in this region",
+	"is not supported in this region",
+}
+
+// testAccErrorCheckT is a resource.TestT which records errors caused by an
+// unavailable service or operation as the reason to skip the test, instead
+// of failing it.
+type testAccErrorCheckT struct {
+	resource.TestT
+
+	skipReason string
+}
+
+func (t *testAccErrorCheckT) Error(args ...interface{}) {
+	if msg := fmt.Sprint(args...); testAccErrorCheckSkippable(msg) {
+		// The test continues to destroy any resources already created.
+		if t.skipReason == "" {
+			t.skipReason = msg
+		}
+		return
+	}
+	t.TestT.Error(args...)
+}
+
+func (t *testAccErrorCheckT) Fatal(args ...interface{}) {
+	if msg := fmt.Sprint(args...); testAccErrorCheckSkippable(msg) {
+		t.TestT.Skip(testAccErrorCheckSkipMessage(msg))
+		return
+	}
+	t.TestT.Fatal(args...)
+}
+
+func testAccErrorCheckSkippable(msg string) bool {
+	for _, fragment := range testAccErrorCheckSkipMessages {
+		if strings.Contains(msg, fragment) {
+			return true
+		}
+	}
+	return false
+}
+
+func testAccErrorCheckSkipMessage(msg string) string {
+	return fmt.Sprintf("skipping test as the service or operation is unavailable in %s: %s", testAccGetRegion(), msg)
+}
+
+// testAccErrorCheckTest runs an acceptance test, skipping rather than failing
+// it when an error shows the service or operation under test is unavailable
+// in the region or partition.
+func testAccErrorCheckTest(t resource.TestT, c resource.TestCase) {
+	et := &testAccErrorCheckT{TestT: t}
+	resource.Test(et, c)
+	if et.skipReason != "" {
+		t.Skip(testAccErrorCheckSkipMessage(et.skipReason))
+	}
+}
@@ -0,0 +1,257 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsAcmCertificate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsAcmCertificateCreate,
+		Read:   resourceAwsAcmCertificateRead,
+		Update: resourceAwsAcmCertificateUpdate,
+		Delete: resourceAwsAcmCertificateDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"domain_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"subject_alternative_names": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"validation_method": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					acm.ValidationMethodDns,
+					acm.ValidationMethodEmail,
+				}, false),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"domain_validation_options": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"domain_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_record_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_record_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_record_value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"validation_emails": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsAcmCertificateCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).acmconn
+
+	params := &acm.RequestCertificateInput{
+		DomainName:       aws.String(d.Get("domain_name").(string)),
+		ValidationMethod: aws.String(d.Get("validation_method").(string)),
+	}
+
+	if v, ok := d.GetOk("subject_alternative_names"); ok {
+		params.SubjectAlternativeNames = expandStringList(v.([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Requesting ACM Certificate: %s", params)
+	resp, err := conn.RequestCertificate(params)
+	if err != nil {
+		return fmt.Errorf("Error requesting ACM Certificate: %s", err)
+	}
+
+	d.SetId(aws.StringValue(resp.CertificateArn))
+
+	if err := setTagsACM(conn, d); err != nil {
+		return err
+	}
+
+	return resourceAwsAcmCertificateRead(d, meta)
+}
+
+func resourceAwsAcmCertificateRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).acmconn
+
+	params := &acm.DescribeCertificateInput{
+		CertificateArn: aws.String(d.Id()),
+	}
+
+	var cert *acm.CertificateDetail
+	// The DNS validation records are populated shortly after the certificate
+	// is requested, so wait for them to be available.
+	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+		resp, err := conn.DescribeCertificate(params)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		cert = resp.Certificate
+		if aws.StringValue(cert.Status) == acm.CertificateStatusPendingValidation && !acmCertificateValidationRecordsAvailable(cert) {
+			return resource.RetryableError(fmt.Errorf("Domain validation records for ACM Certificate (%s) are not yet available", d.Id()))
+		}
+
+		return nil
+	})
+	if err != nil {
+		if isAWSErr(err, acm.ErrCodeResourceNotFoundException, "") {
+			log.Printf("[WARN] ACM Certificate (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error describing ACM Certificate (%s): %s", d.Id(), err)
+	}
+
+	d.Set("domain_name", cert.DomainName)
+	d.Set("arn", cert.CertificateArn)
+
+	if err := d.Set("subject_alternative_names", flattenAcmCertificateSubjectAlternativeNames(cert)); err != nil {
+		return fmt.Errorf("Error setting subject_alternative_names: %s", err)
+	}
+
+	domainValidationOptions, validationEmails, validationMethod := flattenAcmCertificateDomainValidationOptions(cert.DomainValidationOptions)
+	if err := d.Set("domain_validation_options", domainValidationOptions); err != nil {
+		return fmt.Errorf("Error setting domain_validation_options: %s", err)
+	}
+	if err := d.Set("validation_emails", validationEmails); err != nil {
+		return fmt.Errorf("Error setting validation_emails: %s", err)
+	}
+	if validationMethod != "" {
+		d.Set("validation_method", validationMethod)
+	}
+
+	tagsResp, err := conn.ListTagsForCertificate(&acm.ListTagsForCertificateInput{
+		CertificateArn: aws.String(d.Id()),
+	})
+	if err != nil {
+		return fmt.Errorf("Error listing tags for ACM Certificate (%s): %s", d.Id(), err)
+	}
+	if err := d.Set("tags", tagsToMapACM(tagsResp.Tags)); err != nil {
+		return fmt.Errorf("Error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsAcmCertificateUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).acmconn
+
+	if err := setTagsACM(conn, d); err != nil {
+		return err
+	}
+
+	return resourceAwsAcmCertificateRead(d, meta)
+}
+
+func resourceAwsAcmCertificateDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).acmconn
+
+	log.Printf("[INFO] Deleting ACM Certificate: %s", d.Id())
+	// The certificate may still be in use by a load balancer or CloudFront
+	// distribution that is being destroyed at the same time.
+	err := resource.Retry(10*time.Minute, func() *resource.RetryError {
+		_, err := conn.DeleteCertificate(&acm.DeleteCertificateInput{
+			CertificateArn: aws.String(d.Id()),
+		})
+		if err != nil {
+			if isAWSErr(err, acm.ErrCodeResourceInUseException, "") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		if isAWSErr(err, acm.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting ACM Certificate (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func acmCertificateValidationRecordsAvailable(cert *acm.CertificateDetail) bool {
+	for _, o := range cert.DomainValidationOptions {
+		if aws.StringValue(o.ValidationMethod) == acm.ValidationMethodDns && o.ResourceRecord == nil {
+			return false
+		}
+	}
+	return true
+}
+
+func flattenAcmCertificateSubjectAlternativeNames(cert *acm.CertificateDetail) []string {
+	sans := make([]string, 0, len(cert.SubjectAlternativeNames))
+	for _, san := range cert.SubjectAlternativeNames {
+		// The domain name is always included in the subject alternative names
+		if aws.StringValue(san) == aws.StringValue(cert.DomainName) {
+			continue
+		}
+		sans = append(sans, aws.StringValue(san))
+	}
+	return sans
+}
+
+func flattenAcmCertificateDomainValidationOptions(options []*acm.DomainValidation) ([]map[string]interface{}, []string, string) {
+	domainValidationOptions := make([]map[string]interface{}, 0)
+	validationEmails := make([]string, 0)
+	var validationMethod string
+
+	for _, o := range options {
+		validationMethod = aws.StringValue(o.ValidationMethod)
+
+		if o.ResourceRecord != nil {
+			domainValidationOptions = append(domainValidationOptions, map[string]interface{}{
+				"domain_name":           aws.StringValue(o.DomainName),
+				"resource_record_name":  aws.StringValue(o.ResourceRecord.Name),
+				"resource_record_type":  aws.StringValue(o.ResourceRecord.Type),
+				"resource_record_value": aws.StringValue(o.ResourceRecord.Value),
+			})
+		}
+
+		for _, email := range o.ValidationEmails {
+			validationEmails = append(validationEmails, aws.StringValue(email))
+		}
+	}
+
+	return domainValidationOptions, validationEmails, validationMethod
+}
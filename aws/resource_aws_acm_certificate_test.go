@@ -0,0 +1,154 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func testAccAwsAcmCertificateRootDomain(t *testing.T) string {
+	rootDomain := os.Getenv("ACM_CERTIFICATE_ROOT_DOMAIN")
+	if rootDomain == "" {
+		t.Skip("Environment variable ACM_CERTIFICATE_ROOT_DOMAIN is not set. " +
+			"It must be set to a domain with a public Route 53 hosted zone to run ACM certificate tests.")
+	}
+	return rootDomain
+}
+
+func TestAccAWSAcmCertificate_dnsValidation(t *testing.T) {
+	rootDomain := testAccAwsAcmCertificateRootDomain(t)
+	domain := fmt.Sprintf("tf-acc-%d.%s", acctest.RandInt(), rootDomain)
+	resourceName := "aws_acm_certificate.cert"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAcmCertificateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAcmCertificateConfig(domain, acm.ValidationMethodDns),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`^arn:[^:]+:acm:[^:]+:[^:]+:certificate/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "domain_name", domain),
+					resource.TestCheckResourceAttr(resourceName, "domain_validation_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "domain_validation_options.0.domain_name", domain),
+					resource.TestCheckResourceAttrSet(resourceName, "domain_validation_options.0.resource_record_name"),
+					resource.TestCheckResourceAttr(resourceName, "domain_validation_options.0.resource_record_type", "CNAME"),
+					resource.TestCheckResourceAttrSet(resourceName, "domain_validation_options.0.resource_record_value"),
+					resource.TestCheckResourceAttr(resourceName, "subject_alternative_names.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "validation_emails.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "validation_method", acm.ValidationMethodDns),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSAcmCertificate_emailValidation(t *testing.T) {
+	rootDomain := testAccAwsAcmCertificateRootDomain(t)
+	domain := fmt.Sprintf("tf-acc-%d.%s", acctest.RandInt(), rootDomain)
+	resourceName := "aws_acm_certificate.cert"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAcmCertificateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAcmCertificateConfig(domain, acm.ValidationMethodEmail),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "domain_name", domain),
+					resource.TestCheckResourceAttr(resourceName, "domain_validation_options.#", "0"),
+					resource.TestMatchResourceAttr(resourceName, "validation_emails.#", regexp.MustCompile(`^[1-9]\d*$`)),
+					resource.TestCheckResourceAttr(resourceName, "validation_method", acm.ValidationMethodEmail),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSAcmCertificate_tags(t *testing.T) {
+	rootDomain := testAccAwsAcmCertificateRootDomain(t)
+	domain := fmt.Sprintf("tf-acc-%d.%s", acctest.RandInt(), rootDomain)
+	resourceName := "aws_acm_certificate.cert"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAcmCertificateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAcmCertificateConfigTags(domain, "bar"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.foo", "bar"),
+				),
+			},
+			{
+				Config: testAccAcmCertificateConfigTags(domain, "baz"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.foo", "baz"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAcmCertificateDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).acmconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_acm_certificate" {
+			continue
+		}
+
+		_, err := conn.DescribeCertificate(&acm.DescribeCertificateInput{
+			CertificateArn: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			if isAWSErr(err, acm.ErrCodeResourceNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		return fmt.Errorf("ACM Certificate still exists: %s", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAcmCertificateConfig(domainName, validationMethod string) string {
+	return fmt.Sprintf(`
+resource "aws_acm_certificate" "cert" {
+  domain_name       = "%s"
+  validation_method = "%s"
+}
+`, domainName, validationMethod)
+}
+
+func testAccAcmCertificateConfigTags(domainName, tagValue string) string {
+	return fmt.Sprintf(`
+resource "aws_acm_certificate" "cert" {
+  domain_name       = "%s"
+  validation_method = "DNS"
+
+  tags {
+    foo = "%s"
+  }
+}
+`, domainName, tagValue)
+}
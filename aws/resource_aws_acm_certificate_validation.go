@@ -0,0 +1,145 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsAcmCertificateValidation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsAcmCertificateValidationCreate,
+		Read:   resourceAwsAcmCertificateValidationRead,
+		Delete: resourceAwsAcmCertificateValidationDelete,
+
+		Schema: map[string]*schema.Schema{
+			"certificate_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"validation_record_fqdns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(45 * time.Minute),
+		},
+	}
+}
+
+func resourceAwsAcmCertificateValidationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).acmconn
+	certificateArn := d.Get("certificate_arn").(string)
+
+	resp, err := conn.DescribeCertificate(&acm.DescribeCertificateInput{
+		CertificateArn: aws.String(certificateArn),
+	})
+	if err != nil {
+		return fmt.Errorf("Error describing ACM Certificate (%s): %s", certificateArn, err)
+	}
+
+	if v, ok := d.GetOk("validation_record_fqdns"); ok {
+		if err := resourceAwsAcmCertificateValidationCheckRecords(resp.Certificate, v.(*schema.Set).List()); err != nil {
+			return err
+		}
+	}
+
+	log.Printf("[DEBUG] Waiting for ACM Certificate (%s) to be issued", certificateArn)
+	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		resp, err := conn.DescribeCertificate(&acm.DescribeCertificateInput{
+			CertificateArn: aws.String(certificateArn),
+		})
+		if err != nil {
+			return resource.NonRetryableError(fmt.Errorf("Error describing ACM Certificate (%s): %s", certificateArn, err))
+		}
+
+		switch status := aws.StringValue(resp.Certificate.Status); status {
+		case acm.CertificateStatusIssued:
+			d.SetId(aws.TimeValue(resp.Certificate.IssuedAt).String())
+			return nil
+		case acm.CertificateStatusPendingValidation:
+			return resource.RetryableError(fmt.Errorf("Expected ACM Certificate (%s) to be issued, but was in state %s", certificateArn, status))
+		default:
+			return resource.NonRetryableError(fmt.Errorf("ACM Certificate (%s) could not be issued, status: %s (%s)", certificateArn, status, aws.StringValue(resp.Certificate.FailureReason)))
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	return resourceAwsAcmCertificateValidationRead(d, meta)
+}
+
+// resourceAwsAcmCertificateValidationCheckRecords ensures every domain
+// validated over DNS has a matching record in validation_record_fqdns, so
+// a missing record fails early rather than after waiting for the timeout.
+func resourceAwsAcmCertificateValidationCheckRecords(cert *acm.CertificateDetail, fqdns []interface{}) error {
+	if aws.StringValue(cert.Status) == acm.CertificateStatusIssued {
+		return nil
+	}
+
+	given := make(map[string]bool, len(fqdns))
+	for _, v := range fqdns {
+		given[strings.TrimSuffix(v.(string), ".")] = true
+	}
+
+	for _, o := range cert.DomainValidationOptions {
+		if aws.StringValue(o.ValidationMethod) != acm.ValidationMethodDns {
+			return fmt.Errorf("validation_record_fqdns is only valid for DNS validation")
+		}
+		if o.ResourceRecord == nil {
+			continue
+		}
+
+		name := strings.TrimSuffix(aws.StringValue(o.ResourceRecord.Name), ".")
+		if !given[name] {
+			return fmt.Errorf("Missing validation record for domain %s: %s", aws.StringValue(o.DomainName), name)
+		}
+	}
+
+	return nil
+}
+
+func resourceAwsAcmCertificateValidationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).acmconn
+
+	resp, err := conn.DescribeCertificate(&acm.DescribeCertificateInput{
+		CertificateArn: aws.String(d.Get("certificate_arn").(string)),
+	})
+	if err != nil {
+		if isAWSErr(err, acm.ErrCodeResourceNotFoundException, "") {
+			log.Printf("[WARN] ACM Certificate (%s) not found, removing validation from state", d.Get("certificate_arn").(string))
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error describing ACM Certificate (%s): %s", d.Get("certificate_arn").(string), err)
+	}
+
+	if status := aws.StringValue(resp.Certificate.Status); status != acm.CertificateStatusIssued {
+		log.Printf("[WARN] ACM Certificate (%s) is in state %s, removing validation from state", d.Get("certificate_arn").(string), status)
+		d.SetId("")
+		return nil
+	}
+
+	return nil
+}
+
+func resourceAwsAcmCertificateValidationDelete(d *schema.ResourceData, meta interface{}) error {
+	// Validation is a one-time operation, there is nothing to delete.
+	log.Printf("[DEBUG] Removing ACM Certificate validation (%s) from state", d.Get("certificate_arn").(string))
+	d.SetId("")
+	return nil
+}
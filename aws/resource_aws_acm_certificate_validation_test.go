@@ -0,0 +1,124 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSAcmCertificateValidation_basic(t *testing.T) {
+	rootDomain := testAccAwsAcmCertificateRootDomain(t)
+	domain := fmt.Sprintf("tf-acc-%d.%s", acctest.RandInt(), rootDomain)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAcmCertificateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAcmCertificateValidationConfig(rootDomain, domain),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("aws_acm_certificate_validation.cert", "certificate_arn", "aws_acm_certificate.cert", "arn"),
+					resource.TestCheckResourceAttr("aws_acm_certificate_validation.cert", "validation_record_fqdns.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSAcmCertificateValidation_missingRecord(t *testing.T) {
+	rootDomain := testAccAwsAcmCertificateRootDomain(t)
+	domain := fmt.Sprintf("tf-acc-%d.%s", acctest.RandInt(), rootDomain)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAcmCertificateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAcmCertificateValidationConfigMissingRecord(domain),
+				ExpectError: regexp.MustCompile("Missing validation record for domain"),
+			},
+		},
+	})
+}
+
+func TestResourceAwsAcmCertificateValidationCheckRecords(t *testing.T) {
+	cert := &acm.CertificateDetail{
+		Status: aws.String(acm.CertificateStatusPendingValidation),
+		DomainValidationOptions: []*acm.DomainValidation{
+			{
+				DomainName:       aws.String("example.com"),
+				ValidationMethod: aws.String(acm.ValidationMethodDns),
+				ResourceRecord: &acm.ResourceRecord{
+					Name:  aws.String("_a79865eb4cd1a6ab990a45779b4e0b96.example.com."),
+					Type:  aws.String("CNAME"),
+					Value: aws.String("_424c7224e9b0146f9a8808af955727d0.acm-validations.aws."),
+				},
+			},
+		},
+	}
+
+	if err := resourceAwsAcmCertificateValidationCheckRecords(cert, []interface{}{"_a79865eb4cd1a6ab990a45779b4e0b96.example.com"}); err != nil {
+		t.Fatalf("expected no error, got: %s", err)
+	}
+
+	if err := resourceAwsAcmCertificateValidationCheckRecords(cert, []interface{}{"_a79865eb4cd1a6ab990a45779b4e0b96.example.com."}); err != nil {
+		t.Fatalf("expected no error with trailing dot, got: %s", err)
+	}
+
+	if err := resourceAwsAcmCertificateValidationCheckRecords(cert, []interface{}{"other.example.com"}); err == nil {
+		t.Fatal("expected an error for a missing validation record")
+	}
+
+	cert.Status = aws.String(acm.CertificateStatusIssued)
+	if err := resourceAwsAcmCertificateValidationCheckRecords(cert, []interface{}{"other.example.com"}); err != nil {
+		t.Fatalf("expected no error for an issued certificate, got: %s", err)
+	}
+}
+
+func testAccAcmCertificateValidationConfig(rootDomain, domainName string) string {
+	return fmt.Sprintf(`
+resource "aws_acm_certificate" "cert" {
+  domain_name       = "%s"
+  validation_method = "DNS"
+}
+
+data "aws_route53_zone" "zone" {
+  name         = "%s."
+  private_zone = false
+}
+
+resource "aws_route53_record" "cert_validation" {
+  name    = "${aws_acm_certificate.cert.domain_validation_options.0.resource_record_name}"
+  type    = "${aws_acm_certificate.cert.domain_validation_options.0.resource_record_type}"
+  zone_id = "${data.aws_route53_zone.zone.id}"
+  records = ["${aws_acm_certificate.cert.domain_validation_options.0.resource_record_value}"]
+  ttl     = 60
+}
+
+resource "aws_acm_certificate_validation" "cert" {
+  certificate_arn         = "${aws_acm_certificate.cert.arn}"
+  validation_record_fqdns = ["${aws_route53_record.cert_validation.fqdn}"]
+}
+`, domainName, rootDomain)
+}
+
+func testAccAcmCertificateValidationConfigMissingRecord(domainName string) string {
+	return fmt.Sprintf(`
+resource "aws_acm_certificate" "cert" {
+  domain_name       = "%s"
+  validation_method = "DNS"
+}
+
+resource "aws_acm_certificate_validation" "cert" {
+  certificate_arn         = "${aws_acm_certificate.cert.arn}"
+  validation_record_fqdns = ["some-wrong-fqdn.example.com"]
+}
+`, domainName)
+}
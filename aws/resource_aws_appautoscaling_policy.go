@@ -21,6 +21,8 @@ func resourceAwsAppautoscalingPolicy() *schema.Resource {
 		Update: resourceAwsAppautoscalingPolicyUpdate,
 		Delete: resourceAwsAppautoscalingPolicyDelete,
 
+		CustomizeDiff: customizeDiffAppautoscalingDynamoDbResourceId,
+
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
 				Type:     schema.TypeString,
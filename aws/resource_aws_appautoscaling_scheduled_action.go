@@ -18,6 +18,8 @@ func resourceAwsAppautoscalingScheduledAction() *schema.Resource {
 		Read:   resourceAwsAppautoscalingScheduledActionRead,
 		Delete: resourceAwsAppautoscalingScheduledActionDelete,
 
+		CustomizeDiff: customizeDiffAppautoscalingDynamoDbResourceId,
+
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
 				Type:     schema.TypeString,
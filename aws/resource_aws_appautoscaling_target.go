@@ -3,8 +3,11 @@ package aws
 import (
 	"fmt"
 	"log"
+	"regexp"
+	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform/config"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 
@@ -19,6 +22,8 @@ func resourceAwsAppautoscalingTarget() *schema.Resource {
 		Read:   resourceAwsAppautoscalingTargetRead,
 		Delete: resourceAwsAppautoscalingTargetDelete,
 
+		CustomizeDiff: customizeDiffAppautoscalingDynamoDbResourceId,
+
 		Schema: map[string]*schema.Schema{
 			"max_capacity": {
 				Type:     schema.TypeInt,
@@ -191,3 +196,51 @@ func getAwsAppautoscalingTarget(resourceId, namespace, dimension string,
 
 	return nil, nil
 }
+
+var appautoscalingDynamoDbResourceIdRegexp = regexp.MustCompile(`^table/[a-zA-Z0-9_.-]{3,255}(/index/[a-zA-Z0-9_.-]{3,255})?$`)
+
+// validateAppautoscalingDynamoDbResourceId checks that a DynamoDB resource ID
+// is of the form table/<table-name> or table/<table-name>/index/<index-name>,
+// and that it refers to a table or index as required by the scalable dimension.
+func validateAppautoscalingDynamoDbResourceId(dimension, resourceId string) error {
+	m := appautoscalingDynamoDbResourceIdRegexp.FindStringSubmatch(resourceId)
+	if m == nil {
+		return fmt.Errorf("DynamoDB resource_id must be of the form table/<table-name> or table/<table-name>/index/<index-name>, got %q", resourceId)
+	}
+
+	isIndex := m[1] != ""
+	switch dimension {
+	case "":
+		// The scalable dimension is optional for scheduled actions.
+	case applicationautoscaling.ScalableDimensionDynamodbTableReadCapacityUnits, applicationautoscaling.ScalableDimensionDynamodbTableWriteCapacityUnits:
+		if isIndex {
+			return fmt.Errorf("scalable_dimension %q requires a table resource_id (table/<table-name>), got %q", dimension, resourceId)
+		}
+	case applicationautoscaling.ScalableDimensionDynamodbIndexReadCapacityUnits, applicationautoscaling.ScalableDimensionDynamodbIndexWriteCapacityUnits:
+		if !isIndex {
+			return fmt.Errorf("scalable_dimension %q requires an index resource_id (table/<table-name>/index/<index-name>), got %q", dimension, resourceId)
+		}
+	default:
+		return fmt.Errorf("scalable_dimension %q is not valid for the %q service namespace", dimension, applicationautoscaling.ServiceNamespaceDynamodb)
+	}
+
+	return nil
+}
+
+// customizeDiffAppautoscalingDynamoDbResourceId validates DynamoDB scalable
+// targets at plan time, as the API only rejects a mismatched resource ID and
+// scalable dimension when the target is registered.
+func customizeDiffAppautoscalingDynamoDbResourceId(diff *schema.ResourceDiff, v interface{}) error {
+	if diff.Get("service_namespace").(string) != applicationautoscaling.ServiceNamespaceDynamodb {
+		return nil
+	}
+
+	resourceId := diff.Get("resource_id").(string)
+	dimension := diff.Get("scalable_dimension").(string)
+	// Skip values interpolated from resources which are yet to be created.
+	if resourceId == "" || strings.Contains(resourceId, config.UnknownVariableValue) || strings.Contains(dimension, config.UnknownVariableValue) {
+		return nil
+	}
+
+	return validateAppautoscalingDynamoDbResourceId(dimension, resourceId)
+}
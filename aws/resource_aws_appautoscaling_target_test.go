@@ -2,6 +2,7 @@ package aws
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -122,6 +123,102 @@ func TestAccAWSAppautoScalingTarget_multipleTargets(t *testing.T) {
 	})
 }
 
+func TestAccAWSAppautoScalingTarget_dynamoDbIndex(t *testing.T) {
+	var target applicationautoscaling.ScalableTarget
+
+	rInt := acctest.RandInt()
+	tableName := fmt.Sprintf("tf_acc_test_table_%d", rInt)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppautoscalingTargetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppautoscalingTargetDynamoDbIndexConfig(tableName, "table/"+tableName+"/index/TestIndex"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppautoscalingTargetExists("aws_appautoscaling_target.index", &target),
+					resource.TestCheckResourceAttr("aws_appautoscaling_target.index", "service_namespace", "dynamodb"),
+					resource.TestCheckResourceAttr("aws_appautoscaling_target.index", "resource_id", "table/"+tableName+"/index/TestIndex"),
+					resource.TestCheckResourceAttr("aws_appautoscaling_target.index", "scalable_dimension", "dynamodb:index:ReadCapacityUnits"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSAppautoScalingTarget_dynamoDbInvalidResourceId(t *testing.T) {
+	tableName := fmt.Sprintf("tf_acc_test_table_%d", acctest.RandInt())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppautoscalingTargetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSAppautoscalingTargetDynamoDbIndexConfig(tableName, "table/"+tableName),
+				ExpectError: regexp.MustCompile(`requires an index resource_id`),
+			},
+		},
+	})
+}
+
+func TestValidateAppautoscalingDynamoDbResourceId(t *testing.T) {
+	cases := []struct {
+		Dimension  string
+		ResourceId string
+		ErrCount   int
+	}{
+		{
+			Dimension:  "dynamodb:table:ReadCapacityUnits",
+			ResourceId: "table/GameScores",
+			ErrCount:   0,
+		},
+		{
+			Dimension:  "dynamodb:index:WriteCapacityUnits",
+			ResourceId: "table/GameScores/index/GameTitleIndex",
+			ErrCount:   0,
+		},
+		{
+			Dimension:  "",
+			ResourceId: "table/GameScores/index/GameTitleIndex",
+			ErrCount:   0,
+		},
+		{
+			Dimension:  "dynamodb:table:WriteCapacityUnits",
+			ResourceId: "table/GameScores/index/GameTitleIndex",
+			ErrCount:   1,
+		},
+		{
+			Dimension:  "dynamodb:index:ReadCapacityUnits",
+			ResourceId: "table/GameScores",
+			ErrCount:   1,
+		},
+		{
+			Dimension:  "ecs:service:DesiredCount",
+			ResourceId: "table/GameScores",
+			ErrCount:   1,
+		},
+		{
+			Dimension:  "dynamodb:table:ReadCapacityUnits",
+			ResourceId: "GameScores",
+			ErrCount:   1,
+		},
+		{
+			Dimension:  "dynamodb:table:ReadCapacityUnits",
+			ResourceId: "table/GameScores/index/",
+			ErrCount:   1,
+		},
+	}
+
+	for _, tc := range cases {
+		err := validateAppautoscalingDynamoDbResourceId(tc.Dimension, tc.ResourceId)
+		if (err != nil) != (tc.ErrCount > 0) {
+			t.Errorf("%q, %q: expected %d errors, got %v", tc.Dimension, tc.ResourceId, tc.ErrCount, err)
+		}
+	}
+}
+
 func testAccCheckAWSAppautoscalingTargetDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).appautoscalingconn
 
@@ -839,3 +936,81 @@ resource "aws_appautoscaling_target" "read" {
 }
 `, tableName)
 }
+
+func testAccAWSAppautoscalingTargetDynamoDbIndexConfig(tableName, resourceId string) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "dynamodb_table_test" {
+  name           = "%s"
+  read_capacity  = 5
+  write_capacity = 5
+  hash_key       = "FooKey"
+
+  attribute {
+    name = "FooKey"
+    type = "S"
+  }
+
+  attribute {
+    name = "BarKey"
+    type = "S"
+  }
+
+  global_secondary_index {
+    name            = "TestIndex"
+    hash_key        = "BarKey"
+    read_capacity   = 5
+    write_capacity  = 5
+    projection_type = "KEYS_ONLY"
+  }
+}
+
+resource "aws_iam_role" "autoscale_role" {
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "application-autoscaling.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_role_policy" "p" {
+  role = "${aws_iam_role.autoscale_role.name}"
+  policy = <<POLICY
+{
+    "Version": "2012-10-17",
+    "Statement": [
+        {
+            "Effect": "Allow",
+            "Action": [
+                "dynamodb:DescribeTable",
+                "dynamodb:UpdateTable",
+                "cloudwatch:PutMetricAlarm",
+                "cloudwatch:DescribeAlarms",
+                "cloudwatch:DeleteAlarms"
+            ],
+            "Resource": "*"
+        }
+    ]
+}
+POLICY
+}
+
+resource "aws_appautoscaling_target" "index" {
+  service_namespace  = "dynamodb"
+  resource_id        = "%s"
+  scalable_dimension = "dynamodb:index:ReadCapacityUnits"
+  role_arn           = "${aws_iam_role.autoscale_role.arn}"
+  min_capacity       = 1
+  max_capacity       = 10
+  depends_on         = ["aws_iam_role_policy.p", "aws_dynamodb_table.dynamodb_table_test"]
+}
+`, tableName, resourceId)
+}
@@ -2,6 +2,7 @@ package aws
 
 import (
 	"fmt"
+	"log"
 	"strings"
 	"time"
 
@@ -76,9 +77,21 @@ func resourceAwsAthenaDatabaseRead(d *schema.ResourceData, meta interface{}) err
 		return err
 	}
 
-	if err := executeAndExpectMatchingRow(*resp.QueryExecutionId, d.Get("name").(string), conn); err != nil {
+	rs, err := queryExecutionResult(*resp.QueryExecutionId, conn)
+	if err != nil {
 		return err
 	}
+
+	for _, row := range rs.Rows {
+		for _, datum := range row.Data {
+			if aws.StringValue(datum.VarCharValue) == d.Get("name").(string) {
+				return nil
+			}
+		}
+	}
+
+	log.Printf("[WARN] Athena database (%s) not found, removing from state", d.Id())
+	d.SetId("")
 	return nil
 }
 
@@ -127,21 +140,6 @@ func executeAndExpectNoRowsWhenCreate(qeid string, d *schema.ResourceData, conn
 	return nil
 }
 
-func executeAndExpectMatchingRow(qeid string, dbName string, conn *athena.Athena) error {
-	rs, err := queryExecutionResult(qeid, conn)
-	if err != nil {
-		return err
-	}
-	for _, row := range rs.Rows {
-		for _, datum := range row.Data {
-			if *datum.VarCharValue == dbName {
-				return nil
-			}
-		}
-	}
-	return fmt.Errorf("[ERROR] Athena not found database: %s, query result: %s", dbName, flattenAthenaResultSet(rs))
-}
-
 func executeAndExpectNoRowsWhenDrop(qeid string, d *schema.ResourceData, conn *athena.Athena) error {
 	rs, err := queryExecutionResult(qeid, conn)
 	if err != nil {
@@ -93,6 +93,12 @@ func resourceAwsAutoscalingNotificationRead(d *schema.ResourceData, meta interfa
 		return err
 	}
 
+	if len(gRaw) == 0 {
+		log.Printf("[WARN] Autoscaling Notification (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
 	// Grab the keys here as the list of Groups
 	var gList []string
 	for k, _ := range gRaw {
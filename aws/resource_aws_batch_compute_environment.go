@@ -258,7 +258,9 @@ func resourceAwsBatchComputeEnvironmentRead(d *schema.ResourceData, meta interfa
 	}
 
 	if len(result.ComputeEnvironments) == 0 {
-		return fmt.Errorf("One compute environment is expected, but AWS return no compute environment")
+		log.Printf("[WARN] Batch Compute Environment (%s) not found, removing from state", computeEnvironmentName)
+		d.SetId("")
+		return nil
 	}
 	computeEnvironment := result.ComputeEnvironments[0]
 
@@ -25,13 +25,10 @@ func resourceAwsBatchJobDefinition() *schema.Resource {
 				ValidateFunc: validateBatchName,
 			},
 			"container_properties": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
-				StateFunc: func(v interface{}) string {
-					json, _ := normalizeJsonString(v)
-					return json
-				},
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				StateFunc:        normalizeJsonStateFunc,
 				DiffSuppressFunc: suppressEquivalentJsonDiffs,
 				ValidateFunc:     validateAwsBatchJobContainerProperties,
 			},
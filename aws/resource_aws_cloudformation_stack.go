@@ -4,16 +4,32 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
+// cloudFormationStackChangeSetKeys are the arguments applied to an existing
+// stack by a change set.
+var cloudFormationStackChangeSetKeys = []string{
+	"capabilities",
+	"iam_role_arn",
+	"notification_arns",
+	"parameters",
+	"tags",
+	"template_body",
+	"template_url",
+}
+
 func resourceAwsCloudFormationStack() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsCloudFormationStackCreate,
@@ -25,6 +41,8 @@ func resourceAwsCloudFormationStack() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: resourceAwsCloudFormationStackCustomizeDiff,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
 			Update: schema.DefaultTimeout(30 * time.Minute),
@@ -38,14 +56,12 @@ func resourceAwsCloudFormationStack() *schema.Resource {
 				ForceNew: true,
 			},
 			"template_body": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateCloudFormationTemplate,
-				StateFunc: func(v interface{}) string {
-					template, _ := normalizeCloudFormationTemplate(v)
-					return template
-				},
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validateCloudFormationTemplate,
+				StateFunc:        normalizeCloudFormationTemplateStateFunc,
+				DiffSuppressFunc: suppressEquivalentJsonOrYamlDiffs,
 			},
 			"template_url": {
 				Type:     schema.TypeString,
@@ -83,14 +99,12 @@ func resourceAwsCloudFormationStack() *schema.Resource {
 				Computed: true,
 			},
 			"policy_body": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateJsonString,
-				StateFunc: func(v interface{}) string {
-					json, _ := normalizeJsonString(v)
-					return json
-				},
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validateJsonString,
+				StateFunc:        normalizeJsonStateFunc,
+				DiffSuppressFunc: suppressEquivalentJsonDiffs,
 			},
 			"policy_url": {
 				Type:     schema.TypeString,
@@ -109,10 +123,91 @@ func resourceAwsCloudFormationStack() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"use_change_set": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"change_set_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"change_set_summary": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"logical_resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"physical_resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"replacement": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
+// resourceAwsCloudFormationStackCustomizeDiff creates a change set for
+// updates of stacks using change sets, so the changes CloudFormation will
+// make are part of the plan. The change set name is derived from its input,
+// so the change set created during plan is found again during apply.
+func resourceAwsCloudFormationStackCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.Get("use_change_set").(bool) {
+		return nil
+	}
+
+	changed := false
+	for _, k := range cloudFormationStackChangeSetKeys {
+		if diff.HasChange(k) {
+			changed = true
+			break
+		}
+	}
+	if !changed {
+		return nil
+	}
+
+	input, known, err := expandCloudFormationChangeSetInput(diff)
+	if err != nil {
+		return err
+	}
+	if !known {
+		// The change set is created once all of its input is known.
+		if err := diff.SetNewComputed("change_set_id"); err != nil {
+			return err
+		}
+		return diff.SetNewComputed("change_set_summary")
+	}
+
+	conn := meta.(*AWSClient).cfconn
+	changeSet, err := createCloudFormationChangeSet(conn, input)
+	if err != nil {
+		return err
+	}
+
+	if err := diff.SetNew("change_set_id", aws.StringValue(changeSet.ChangeSetId)); err != nil {
+		return err
+	}
+	return diff.SetNew("change_set_summary", flattenCloudFormationChangeSetSummary(changeSet.Changes))
+}
+
 func resourceAwsCloudFormationStackCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).cfconn
 
@@ -355,6 +450,77 @@ func resourceAwsCloudFormationStackRead(d *schema.ResourceData, meta interface{}
 func resourceAwsCloudFormationStackUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).cfconn
 
+	if d.Get("use_change_set").(bool) {
+		if err := resourceAwsCloudFormationStackExecuteChangeSet(d, conn); err != nil {
+			return err
+		}
+	} else {
+		if err := resourceAwsCloudFormationStackUpdateStack(d, conn); err != nil {
+			return err
+		}
+	}
+
+	lastUpdatedTime, err := getLastCfEventTimestamp(d.Id(), conn)
+	if err != nil {
+		return err
+	}
+
+	var lastStatus string
+	var stackId string
+	wait := resource.StateChangeConf{
+		Pending: []string{
+			"UPDATE_COMPLETE_CLEANUP_IN_PROGRESS",
+			"UPDATE_IN_PROGRESS",
+			"UPDATE_ROLLBACK_IN_PROGRESS",
+			"UPDATE_ROLLBACK_COMPLETE_CLEANUP_IN_PROGRESS",
+		},
+		Target: []string{
+			"CREATE_COMPLETE", // If no stack update was performed
+			"UPDATE_COMPLETE",
+			"UPDATE_ROLLBACK_COMPLETE",
+			"UPDATE_ROLLBACK_FAILED",
+		},
+		Timeout:    d.Timeout(schema.TimeoutUpdate),
+		MinTimeout: 5 * time.Second,
+		Refresh: func() (interface{}, string, error) {
+			resp, err := conn.DescribeStacks(&cloudformation.DescribeStacksInput{
+				StackName: aws.String(d.Id()),
+			})
+			if err != nil {
+				log.Printf("[ERROR] Failed to describe stacks: %s", err)
+				return nil, "", err
+			}
+
+			stackId = aws.StringValue(resp.Stacks[0].StackId)
+
+			status := *resp.Stacks[0].StackStatus
+			lastStatus = status
+			log.Printf("[DEBUG] Current CloudFormation stack status: %q", status)
+
+			return resp, status, err
+		},
+	}
+
+	_, err = wait.WaitForState()
+	if err != nil {
+		return err
+	}
+
+	if lastStatus == "UPDATE_ROLLBACK_COMPLETE" || lastStatus == "UPDATE_ROLLBACK_FAILED" {
+		reasons, err := getCloudFormationRollbackReasons(stackId, lastUpdatedTime, conn)
+		if err != nil {
+			return fmt.Errorf("Failed getting details about rollback: %q", err.Error())
+		}
+
+		return fmt.Errorf("%s: %q", lastStatus, reasons)
+	}
+
+	log.Printf("[DEBUG] CloudFormation stack %q has been updated", stackId)
+
+	return resourceAwsCloudFormationStackRead(d, meta)
+}
+
+func resourceAwsCloudFormationStackUpdateStack(d *schema.ResourceData, conn *cloudformation.CloudFormation) error {
 	input := &cloudformation.UpdateStackInput{
 		StackName: aws.String(d.Id()),
 	}
@@ -418,64 +584,249 @@ func resourceAwsCloudFormationStackUpdate(d *schema.ResourceData, meta interface
 		log.Printf("[DEBUG] Current CloudFormation stack has no updates")
 	}
 
-	lastUpdatedTime, err := getLastCfEventTimestamp(d.Id(), conn)
+	return nil
+}
+
+func resourceAwsCloudFormationStackExecuteChangeSet(d *schema.ResourceData, conn *cloudformation.CloudFormation) error {
+	// Change sets don't include the stack policy.
+	if d.HasChange("policy_body") || d.HasChange("policy_url") {
+		input := &cloudformation.SetStackPolicyInput{
+			StackName: aws.String(d.Id()),
+		}
+		if v, ok := d.GetOk("policy_body"); ok {
+			policy, err := normalizeJsonString(v)
+			if err != nil {
+				return errwrap.Wrapf("policy body contains an invalid JSON: {{err}}", err)
+			}
+			input.StackPolicyBody = aws.String(policy)
+		} else if v, ok := d.GetOk("policy_url"); ok {
+			input.StackPolicyURL = aws.String(v.(string))
+		}
+
+		if input.StackPolicyBody != nil || input.StackPolicyURL != nil {
+			log.Printf("[DEBUG] Setting CloudFormation stack policy: %s", input)
+			if _, err := conn.SetStackPolicy(input); err != nil {
+				return fmt.Errorf("Error setting CloudFormation stack (%s) policy: %s", d.Id(), err)
+			}
+		}
+	}
+
+	changeSetId := d.Get("change_set_id").(string)
+	if changeSetId == "" {
+		log.Printf("[DEBUG] CloudFormation stack change set has no changes")
+		return nil
+	}
+
+	changed := false
+	for _, k := range cloudFormationStackChangeSetKeys {
+		if d.HasChange(k) {
+			changed = true
+			break
+		}
+	}
+	if !changed {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Executing CloudFormation change set: %s", changeSetId)
+	_, err := conn.ExecuteChangeSet(&cloudformation.ExecuteChangeSetInput{
+		ChangeSetName: aws.String(changeSetId),
+		StackName:     aws.String(d.Id()),
+	})
 	if err != nil {
-		return err
+		return fmt.Errorf("Error executing CloudFormation change set (%s): %s", changeSetId, err)
+	}
+
+	return nil
+}
+
+// expandCloudFormationChangeSetInput returns the input of a change set that
+// updates the stack to the planned arguments. It reports false when some of
+// the arguments are only known during apply.
+func expandCloudFormationChangeSetInput(diff *schema.ResourceDiff) (*cloudformation.CreateChangeSetInput, bool, error) {
+	input := &cloudformation.CreateChangeSetInput{
+		ChangeSetType: aws.String(cloudformation.ChangeSetTypeUpdate),
+		StackName:     aws.String(diff.Id()),
+	}
+
+	if v, ok := diff.GetOk("template_url"); ok {
+		input.TemplateURL = aws.String(v.(string))
+	} else if v, ok := diff.GetOk("template_body"); ok {
+		template, err := normalizeCloudFormationTemplate(v)
+		if err != nil {
+			return nil, false, errwrap.Wrapf("template body contains an invalid JSON or YAML: {{err}}", err)
+		}
+		input.TemplateBody = aws.String(template)
+	}
+	if input.TemplateURL == nil && input.TemplateBody == nil {
+		return nil, false, nil
+	}
+	if strings.Contains(aws.StringValue(input.TemplateURL), config.UnknownVariableValue) ||
+		strings.Contains(aws.StringValue(input.TemplateBody), config.UnknownVariableValue) {
+		return nil, false, nil
+	}
+
+	if v, ok := diff.GetOk("capabilities"); ok {
+		input.Capabilities = expandStringList(v.(*schema.Set).List())
+	}
+	input.NotificationARNs = expandStringList(diff.Get("notification_arns").(*schema.Set).List())
+
+	if v, ok := diff.GetOk("parameters"); ok {
+		params := v.(map[string]interface{})
+		for _, value := range params {
+			if value == config.UnknownVariableValue {
+				return nil, false, nil
+			}
+		}
+		input.Parameters = expandCloudFormationParameters(params)
+		sort.Slice(input.Parameters, func(i, j int) bool {
+			return aws.StringValue(input.Parameters[i].ParameterKey) < aws.StringValue(input.Parameters[j].ParameterKey)
+		})
+	}
+
+	if v, ok := diff.GetOk("tags"); ok {
+		input.Tags = expandCloudFormationTags(v.(map[string]interface{}))
+		sort.Slice(input.Tags, func(i, j int) bool {
+			return aws.StringValue(input.Tags[i].Key) < aws.StringValue(input.Tags[j].Key)
+		})
+	}
+
+	if v, ok := diff.GetOk("iam_role_arn"); ok {
+		input.RoleARN = aws.String(v.(string))
+	}
+
+	input.ChangeSetName = aws.String(fmt.Sprintf("terraform-%d", hashcode.String(input.String())))
+
+	return input, true, nil
+}
+
+// createCloudFormationChangeSet returns the change set of the given input,
+// creating it unless it already exists. A change set without changes is
+// returned without an ID.
+func createCloudFormationChangeSet(conn *cloudformation.CloudFormation, input *cloudformation.CreateChangeSetInput) (*cloudformation.DescribeChangeSetOutput, error) {
+	describeInput := &cloudformation.DescribeChangeSetInput{
+		ChangeSetName: input.ChangeSetName,
+		StackName:     input.StackName,
+	}
+
+	changeSet, err := describeCloudFormationChangeSet(conn, describeInput)
+	if err != nil && !isAWSErr(err, cloudformation.ErrCodeChangeSetNotFoundException, "") {
+		return nil, fmt.Errorf("Error describing CloudFormation change set (%s): %s", aws.StringValue(input.ChangeSetName), err)
+	}
+
+	// Change sets made obsolete by another update of the stack are replaced.
+	if changeSet != nil && aws.StringValue(changeSet.ExecutionStatus) == cloudformation.ExecutionStatusObsolete {
+		if err := deleteCloudFormationChangeSet(conn, describeInput); err != nil {
+			return nil, err
+		}
+		changeSet = nil
+	}
+
+	if changeSet == nil {
+		log.Printf("[DEBUG] Creating CloudFormation change set: %s", input)
+		if _, err := conn.CreateChangeSet(input); err != nil {
+			return nil, fmt.Errorf("Error creating CloudFormation change set (%s): %s", aws.StringValue(input.ChangeSetName), err)
+		}
 	}
 
-	var lastStatus string
-	var stackId string
 	wait := resource.StateChangeConf{
 		Pending: []string{
-			"UPDATE_COMPLETE_CLEANUP_IN_PROGRESS",
-			"UPDATE_IN_PROGRESS",
-			"UPDATE_ROLLBACK_IN_PROGRESS",
-			"UPDATE_ROLLBACK_COMPLETE_CLEANUP_IN_PROGRESS",
+			cloudformation.ChangeSetStatusCreatePending,
+			cloudformation.ChangeSetStatusCreateInProgress,
 		},
 		Target: []string{
-			"CREATE_COMPLETE", // If no stack update was performed
-			"UPDATE_COMPLETE",
-			"UPDATE_ROLLBACK_COMPLETE",
-			"UPDATE_ROLLBACK_FAILED",
+			cloudformation.ChangeSetStatusCreateComplete,
+			cloudformation.ChangeSetStatusFailed,
 		},
-		Timeout:    d.Timeout(schema.TimeoutUpdate),
+		Timeout:    5 * time.Minute,
 		MinTimeout: 5 * time.Second,
 		Refresh: func() (interface{}, string, error) {
-			resp, err := conn.DescribeStacks(&cloudformation.DescribeStacksInput{
-				StackName: aws.String(d.Id()),
-			})
+			changeSet, err := describeCloudFormationChangeSet(conn, describeInput)
 			if err != nil {
-				log.Printf("[ERROR] Failed to describe stacks: %s", err)
 				return nil, "", err
 			}
-
-			stackId = aws.StringValue(resp.Stacks[0].StackId)
-
-			status := *resp.Stacks[0].StackStatus
-			lastStatus = status
-			log.Printf("[DEBUG] Current CloudFormation stack status: %q", status)
-
-			return resp, status, err
+			return changeSet, aws.StringValue(changeSet.Status), nil
 		},
 	}
 
-	_, err = wait.WaitForState()
+	v, err := wait.WaitForState()
 	if err != nil {
-		return err
+		return nil, fmt.Errorf("Error waiting for CloudFormation change set (%s) to be created: %s", aws.StringValue(input.ChangeSetName), err)
 	}
+	changeSet = v.(*cloudformation.DescribeChangeSetOutput)
 
-	if lastStatus == "UPDATE_ROLLBACK_COMPLETE" || lastStatus == "UPDATE_ROLLBACK_FAILED" {
-		reasons, err := getCloudFormationRollbackReasons(stackId, lastUpdatedTime, conn)
+	if aws.StringValue(changeSet.Status) == cloudformation.ChangeSetStatusFailed {
+		reason := aws.StringValue(changeSet.StatusReason)
+		if strings.Contains(reason, "didn't contain changes") || strings.Contains(reason, "No updates are to be performed") {
+			log.Printf("[DEBUG] CloudFormation change set (%s) has no changes", aws.StringValue(input.ChangeSetName))
+			return &cloudformation.DescribeChangeSetOutput{}, nil
+		}
+
+		if err := deleteCloudFormationChangeSet(conn, describeInput); err != nil {
+			log.Printf("[WARN] %s", err)
+		}
+		return nil, fmt.Errorf("CloudFormation change set (%s) failed: %s", aws.StringValue(input.ChangeSetName), reason)
+	}
+
+	return changeSet, nil
+}
+
+// describeCloudFormationChangeSet returns the change set with all of its
+// changes.
+func describeCloudFormationChangeSet(conn *cloudformation.CloudFormation, input *cloudformation.DescribeChangeSetInput) (*cloudformation.DescribeChangeSetOutput, error) {
+	var changeSet *cloudformation.DescribeChangeSetOutput
+	var changes []*cloudformation.Change
+
+	input = &cloudformation.DescribeChangeSetInput{
+		ChangeSetName: input.ChangeSetName,
+		StackName:     input.StackName,
+	}
+	for {
+		resp, err := conn.DescribeChangeSet(input)
 		if err != nil {
-			return fmt.Errorf("Failed getting details about rollback: %q", err.Error())
+			return nil, err
 		}
+		changeSet = resp
+		changes = append(changes, resp.Changes...)
 
-		return fmt.Errorf("%s: %q", lastStatus, reasons)
+		if resp.NextToken == nil {
+			break
+		}
+		input.NextToken = resp.NextToken
 	}
 
-	log.Printf("[DEBUG] CloudFormation stack %q has been updated", stackId)
+	changeSet.Changes = changes
+	return changeSet, nil
+}
 
-	return resourceAwsCloudFormationStackRead(d, meta)
+func deleteCloudFormationChangeSet(conn *cloudformation.CloudFormation, input *cloudformation.DescribeChangeSetInput) error {
+	log.Printf("[DEBUG] Deleting CloudFormation change set: %s", aws.StringValue(input.ChangeSetName))
+	_, err := conn.DeleteChangeSet(&cloudformation.DeleteChangeSetInput{
+		ChangeSetName: input.ChangeSetName,
+		StackName:     input.StackName,
+	})
+	if err != nil && !isAWSErr(err, cloudformation.ErrCodeChangeSetNotFoundException, "") {
+		return fmt.Errorf("Error deleting CloudFormation change set (%s): %s", aws.StringValue(input.ChangeSetName), err)
+	}
+	return nil
+}
+
+func flattenCloudFormationChangeSetSummary(changes []*cloudformation.Change) []interface{} {
+	summary := make([]interface{}, 0, len(changes))
+	for _, change := range changes {
+		rc := change.ResourceChange
+		if rc == nil {
+			continue
+		}
+		summary = append(summary, map[string]interface{}{
+			"action":               aws.StringValue(rc.Action),
+			"logical_resource_id":  aws.StringValue(rc.LogicalResourceId),
+			"physical_resource_id": aws.StringValue(rc.PhysicalResourceId),
+			"replacement":          aws.StringValue(rc.Replacement),
+			"resource_type":        aws.StringValue(rc.ResourceType),
+		})
+	}
+	return summary
 }
 
 func resourceAwsCloudFormationStackDelete(d *schema.ResourceData, meta interface{}) error {
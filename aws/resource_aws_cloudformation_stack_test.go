@@ -166,6 +166,41 @@ func TestAccAWSCloudFormation_withParams(t *testing.T) {
 	})
 }
 
+func TestAccAWSCloudFormation_useChangeSet(t *testing.T) {
+	var stack cloudformation.Stack
+	stackName := fmt.Sprintf("tf-acc-test-change-set-%s", acctest.RandString(10))
+	resourceName := "aws_cloudformation_stack.with_params"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudFormationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFormationConfig_useChangeSet(stackName, "10.0.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFormationStackExists(resourceName, &stack),
+					resource.TestCheckResourceAttr(resourceName, "change_set_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "change_set_summary.#", "0"),
+				),
+			},
+			{
+				Config: testAccAWSCloudFormationConfig_useChangeSet(stackName, "12.0.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFormationStackExists(resourceName, &stack),
+					resource.TestCheckResourceAttr(resourceName, "parameters.VpcCIDR", "12.0.0.0/16"),
+					resource.TestCheckResourceAttrSet(resourceName, "change_set_id"),
+					resource.TestCheckResourceAttr(resourceName, "change_set_summary.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "change_set_summary.0.action", "Modify"),
+					resource.TestCheckResourceAttr(resourceName, "change_set_summary.0.logical_resource_id", "MyVPC"),
+					resource.TestCheckResourceAttr(resourceName, "change_set_summary.0.replacement", "True"),
+					resource.TestCheckResourceAttr(resourceName, "change_set_summary.0.resource_type", "AWS::EC2::VPC"),
+				),
+			},
+		},
+	})
+}
+
 // Regression for https://github.com/hashicorp/terraform/issues/4534
 func TestAccAWSCloudFormation_withUrl_withParams(t *testing.T) {
 	var stack cloudformation.Stack
@@ -564,6 +599,38 @@ func testAccAWSCloudFormationConfig_withParams_modified(stackName string) string
 		"12.0.0.0/16")
 }
 
+func testAccAWSCloudFormationConfig_useChangeSet(stackName, vpcCidr string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudformation_stack" "with_params" {
+  name           = "%s"
+  use_change_set = true
+
+  parameters {
+    VpcCIDR = "%s"
+  }
+
+  template_body = <<STACK
+{
+  "Parameters" : {
+    "VpcCIDR" : {
+      "Description" : "CIDR to be used for the VPC",
+      "Type" : "String"
+    }
+  },
+  "Resources" : {
+    "MyVPC": {
+      "Type" : "AWS::EC2::VPC",
+      "Properties" : {
+        "CidrBlock" : {"Ref": "VpcCIDR"}
+      }
+    }
+  }
+}
+STACK
+}
+`, stackName, vpcCidr)
+}
+
 func testAccAWSCloudFormationConfig_templateUrl_withParams(rName, bucketKey, vpcCidr string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "b" {
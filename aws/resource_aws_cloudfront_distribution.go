@@ -529,14 +529,21 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			// retain_on_delete is a non-API attribute that may help facilitate speedy
-			// deletion of a resoruce. It's mainly here for testing purposes, so
-			// enable at your own risk.
+			// retain_on_delete is a non-API attribute that only disables the
+			// distribution on destroy, skipping the wait for it to be deployed
+			// before it can be deleted.
 			"retain_on_delete": {
 				Type:     schema.TypeBool,
 				Optional: true,
 				Default:  false,
 			},
+			// wait_for_deployment is a non-API attribute that controls whether
+			// create and update wait for the distribution to be deployed.
+			"wait_for_deployment": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"is_ipv6_enabled": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -563,6 +570,14 @@ func resourceAwsCloudFrontDistributionCreate(d *schema.ResourceData, meta interf
 		return err
 	}
 	d.SetId(*resp.Distribution.Id)
+
+	if d.Get("wait_for_deployment").(bool) {
+		log.Printf("[DEBUG] Waiting until CloudFront Distribution (%s) is deployed", d.Id())
+		if err := resourceAwsCloudFrontDistributionWaitUntilDeployed(d.Id(), meta); err != nil {
+			return fmt.Errorf("Error waiting until CloudFront Distribution (%s) is deployed: %s", d.Id(), err)
+		}
+	}
+
 	return resourceAwsCloudFrontDistributionRead(d, meta)
 }
 
@@ -625,11 +640,20 @@ func resourceAwsCloudFrontDistributionUpdate(d *schema.ResourceData, meta interf
 		DistributionConfig: expandDistributionConfig(d),
 		IfMatch:            aws.String(d.Get("etag").(string)),
 	}
+	unlock := lockMutation(meta, awsMutexCloudFrontDistributionKey)
 	_, err := conn.UpdateDistribution(params)
+	unlock()
 	if err != nil {
 		return err
 	}
 
+	if d.Get("wait_for_deployment").(bool) {
+		log.Printf("[DEBUG] Waiting until CloudFront Distribution (%s) is deployed", d.Id())
+		if err := resourceAwsCloudFrontDistributionWaitUntilDeployed(d.Id(), meta); err != nil {
+			return fmt.Errorf("Error waiting until CloudFront Distribution (%s) is deployed: %s", d.Id(), err)
+		}
+	}
+
 	if err := setTagsCloudFront(conn, d, d.Get("arn").(string)); err != nil {
 		return err
 	}
@@ -642,9 +666,18 @@ func resourceAwsCloudFrontDistributionDelete(d *schema.ResourceData, meta interf
 
 	// manually disable the distribution first
 	d.Set("enabled", false)
-	err := resourceAwsCloudFrontDistributionUpdate(d, meta)
+	unlock := lockMutation(meta, awsMutexCloudFrontDistributionKey)
+	updateResp, err := conn.UpdateDistribution(&cloudfront.UpdateDistributionInput{
+		Id:                 aws.String(d.Id()),
+		DistributionConfig: expandDistributionConfig(d),
+		IfMatch:            aws.String(d.Get("etag").(string)),
+	})
+	unlock()
 	if err != nil {
-		return err
+		if isAWSErr(err, cloudfront.ErrCodeNoSuchDistribution, "") {
+			return nil
+		}
+		return fmt.Errorf("Error disabling CloudFront Distribution (%s): %s", d.Id(), err)
 	}
 
 	// skip delete if retain_on_delete is enabled
@@ -663,7 +696,7 @@ func resourceAwsCloudFrontDistributionDelete(d *schema.ResourceData, meta interf
 	// now delete
 	params := &cloudfront.DeleteDistributionInput{
 		Id:      aws.String(d.Id()),
-		IfMatch: aws.String(d.Get("etag").(string)),
+		IfMatch: updateResp.ETag,
 	}
 
 	_, err = conn.DeleteDistribution(params)
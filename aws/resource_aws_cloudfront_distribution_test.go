@@ -215,6 +215,40 @@ func TestAccAWSCloudFrontDistribution_noCustomErrorResponseConfig(t *testing.T)
 	})
 }
 
+func TestAccAWSCloudFrontDistribution_WaitForDeployment(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFrontDistributionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFrontDistributionWaitForDeploymentConfig(""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFrontDistributionExistence(
+						"aws_cloudfront_distribution.wait_for_deployment",
+					),
+					resource.TestCheckResourceAttr(
+						"aws_cloudfront_distribution.wait_for_deployment", "wait_for_deployment", "false"),
+					resource.TestCheckResourceAttr(
+						"aws_cloudfront_distribution.wait_for_deployment", "status", "InProgress"),
+				),
+			},
+			{
+				Config: testAccAWSCloudFrontDistributionWaitForDeploymentConfig("wait_for_deployment = true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFrontDistributionExistence(
+						"aws_cloudfront_distribution.wait_for_deployment",
+					),
+					resource.TestCheckResourceAttr(
+						"aws_cloudfront_distribution.wait_for_deployment", "wait_for_deployment", "true"),
+					resource.TestCheckResourceAttr(
+						"aws_cloudfront_distribution.wait_for_deployment", "status", "Deployed"),
+				),
+			},
+		},
+	})
+}
+
 func TestResourceAWSCloudFrontDistribution_validateHTTP(t *testing.T) {
 	var value string
 	var errors []error
@@ -722,6 +756,50 @@ resource "aws_cloudfront_distribution" "no_optional_items" {
 }
 `, rand.New(rand.NewSource(time.Now().UnixNano())).Int(), testAccAWSCloudFrontDistributionRetainConfig())
 
+func testAccAWSCloudFrontDistributionWaitForDeploymentConfig(waitForDeployment string) string {
+	return fmt.Sprintf(`
+variable rand_id {
+	default = %d
+}
+
+resource "aws_cloudfront_distribution" "wait_for_deployment" {
+	origin {
+		domain_name = "www.example.com"
+		origin_id = "myCustomOrigin"
+		custom_origin_config {
+			http_port = 80
+			https_port = 443
+			origin_protocol_policy = "http-only"
+			origin_ssl_protocols = [ "TLSv1.2" ]
+		}
+	}
+	enabled = true
+	default_cache_behavior {
+		allowed_methods = [ "GET", "HEAD" ]
+		cached_methods = [ "GET", "HEAD" ]
+		target_origin_id = "myCustomOrigin"
+		forwarded_values {
+			query_string = false
+			cookies {
+				forward = "all"
+			}
+		}
+		viewer_protocol_policy = "allow-all"
+	}
+	restrictions {
+		geo_restriction {
+			restriction_type = "none"
+		}
+	}
+	viewer_certificate {
+		cloudfront_default_certificate = true
+	}
+	%s
+	%s
+}
+`, rand.New(rand.NewSource(time.Now().UnixNano())).Int(), waitForDeployment, testAccAWSCloudFrontDistributionRetainConfig())
+}
+
 var testAccAWSCloudFrontDistributionHTTP11Config = fmt.Sprintf(`
 variable rand_id {
 	default = %d
@@ -30,13 +30,10 @@ func resourceAwsCloudWatchDashboard() *schema.Resource {
 				Computed: true,
 			},
 			"dashboard_body": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateJsonString,
-				StateFunc: func(v interface{}) string {
-					json, _ := normalizeJsonString(v)
-					return json
-				},
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validateJsonString,
+				StateFunc:        normalizeJsonStateFunc,
 				DiffSuppressFunc: suppressEquivalentJsonDiffs,
 			},
 			"dashboard_name": {
@@ -0,0 +1,195 @@
+package aws
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	events "github.com/aws/aws-sdk-go/service/cloudwatchevents"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsCloudWatchEventPermission() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCloudWatchEventPermissionCreate,
+		Read:   resourceAwsCloudWatchEventPermissionRead,
+		Delete: resourceAwsCloudWatchEventPermissionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"action": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "events:PutEvents",
+				ValidateFunc: validation.StringInSlice([]string{
+					"events:PutEvents",
+				}, false),
+			},
+			"principal": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCloudWatchEventPermissionPrincipal,
+			},
+			"statement_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCloudWatchEventPermissionStatementID,
+			},
+		},
+	}
+}
+
+func resourceAwsCloudWatchEventPermissionCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatcheventsconn
+	statementID := d.Get("statement_id").(string)
+
+	input := &events.PutPermissionInput{
+		Action:      aws.String(d.Get("action").(string)),
+		Principal:   aws.String(d.Get("principal").(string)),
+		StatementId: aws.String(statementID),
+	}
+
+	log.Printf("[DEBUG] Creating CloudWatch Events permission: %s", input)
+	_, err := conn.PutPermission(input)
+	if err != nil {
+		return fmt.Errorf("Error creating CloudWatch Events permission: %s", err)
+	}
+
+	d.SetId(statementID)
+
+	return resourceAwsCloudWatchEventPermissionRead(d, meta)
+}
+
+func resourceAwsCloudWatchEventPermissionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatcheventsconn
+
+	log.Printf("[DEBUG] Reading CloudWatch Events bus policy")
+	output, err := conn.DescribeEventBus(&events.DescribeEventBusInput{})
+	if err != nil {
+		return fmt.Errorf("Error reading CloudWatch Events bus policy: %s", err)
+	}
+
+	statement, err := findCloudWatchEventPermissionPolicyStatement(aws.StringValue(output.Policy), d.Id())
+	if err != nil {
+		return err
+	}
+	if statement == nil {
+		log.Printf("[WARN] CloudWatch Events permission (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	principal, err := getCloudWatchEventPermissionPrincipal(statement.Principal)
+	if err != nil {
+		return err
+	}
+
+	d.Set("action", statement.Action)
+	d.Set("principal", principal)
+	d.Set("statement_id", statement.Sid)
+
+	return nil
+}
+
+func resourceAwsCloudWatchEventPermissionDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatcheventsconn
+
+	log.Printf("[DEBUG] Deleting CloudWatch Events permission: %s", d.Id())
+	_, err := conn.RemovePermission(&events.RemovePermissionInput{
+		StatementId: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, events.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting CloudWatch Events permission (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// CloudWatchEventPermissionPolicyDoc is the policy document of the default
+// event bus, as returned by DescribeEventBus.
+type CloudWatchEventPermissionPolicyDoc struct {
+	Version    string                                     `json:"Version"`
+	ID         string                                     `json:"Id,omitempty"`
+	Statements []CloudWatchEventPermissionPolicyStatement `json:"Statement"`
+}
+
+// CloudWatchEventPermissionPolicyStatement is a statement of the event bus
+// policy. Principal is either "*" or {"AWS": "arn:aws:iam::ACCOUNT:root"}.
+type CloudWatchEventPermissionPolicyStatement struct {
+	Sid       string      `json:"Sid"`
+	Effect    string      `json:"Effect"`
+	Action    string      `json:"Action"`
+	Principal interface{} `json:"Principal"`
+	Resource  string      `json:"Resource"`
+}
+
+func findCloudWatchEventPermissionPolicyStatement(policy, statementID string) (*CloudWatchEventPermissionPolicyStatement, error) {
+	if policy == "" {
+		return nil, nil
+	}
+
+	var doc CloudWatchEventPermissionPolicyDoc
+	if err := json.Unmarshal([]byte(policy), &doc); err != nil {
+		return nil, fmt.Errorf("Error parsing CloudWatch Events bus policy: %s", err)
+	}
+
+	for _, statement := range doc.Statements {
+		if statement.Sid == statementID {
+			return &statement, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// getCloudWatchEventPermissionPrincipal returns the principal of the statement
+// as it is given to PutPermission: "*" or an AWS account ID.
+func getCloudWatchEventPermissionPrincipal(principal interface{}) (string, error) {
+	switch p := principal.(type) {
+	case string:
+		if p == "*" {
+			return p, nil
+		}
+	case map[string]interface{}:
+		if v, ok := p["AWS"].(string); ok {
+			principalArn, err := arn.Parse(v)
+			if err != nil {
+				return "", fmt.Errorf("Error parsing CloudWatch Events permission principal %q: %s", v, err)
+			}
+			return principalArn.AccountID, nil
+		}
+	}
+
+	return "", fmt.Errorf("Unexpected CloudWatch Events permission principal: %#v", principal)
+}
+
+func validateCloudWatchEventPermissionPrincipal(v interface{}, k string) (ws []string, es []error) {
+	value := v.(string)
+	if !regexp.MustCompile(`^(\d{12}|\*)$`).MatchString(value) {
+		es = append(es, fmt.Errorf("%q must be * or a 12 digit AWS account ID", k))
+	}
+	return
+}
+
+func validateCloudWatchEventPermissionStatementID(v interface{}, k string) (ws []string, es []error) {
+	value := v.(string)
+	if len(value) < 1 || len(value) > 64 {
+		es = append(es, fmt.Errorf("%q must be between 1 and 64 characters", k))
+	}
+	if !regexp.MustCompile(`^[a-zA-Z0-9-_]+$`).MatchString(value) {
+		es = append(es, fmt.Errorf("%q must only contain alphanumeric characters, hyphens and underscores", k))
+	}
+	return
+}
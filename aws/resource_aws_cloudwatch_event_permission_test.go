@@ -0,0 +1,184 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	events "github.com/aws/aws-sdk-go/service/cloudwatchevents"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestFindCloudWatchEventPermissionPolicyStatement(t *testing.T) {
+	policy := `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "Account",
+      "Effect": "Allow",
+      "Principal": {"AWS": "arn:aws:iam::123456789012:root"},
+      "Action": "events:PutEvents",
+      "Resource": "arn:aws:events:us-east-1:111111111111:event-bus/default"
+    },
+    {
+      "Sid": "Everybody",
+      "Effect": "Allow",
+      "Principal": "*",
+      "Action": "events:PutEvents",
+      "Resource": "arn:aws:events:us-east-1:111111111111:event-bus/default"
+    }
+  ]
+}`
+
+	cases := []struct {
+		StatementID string
+		Principal   string
+	}{
+		{StatementID: "Account", Principal: "123456789012"},
+		{StatementID: "Everybody", Principal: "*"},
+		{StatementID: "Missing"},
+	}
+
+	for _, tc := range cases {
+		statement, err := findCloudWatchEventPermissionPolicyStatement(policy, tc.StatementID)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", tc.StatementID, err)
+		}
+
+		if tc.Principal == "" {
+			if statement != nil {
+				t.Fatalf("%s: expected no statement, got %#v", tc.StatementID, statement)
+			}
+			continue
+		}
+
+		if statement == nil {
+			t.Fatalf("%s: expected a statement", tc.StatementID)
+		}
+		principal, err := getCloudWatchEventPermissionPrincipal(statement.Principal)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", tc.StatementID, err)
+		}
+		if principal != tc.Principal {
+			t.Fatalf("%s: expected principal %q, got %q", tc.StatementID, tc.Principal, principal)
+		}
+	}
+
+	if statement, err := findCloudWatchEventPermissionPolicyStatement("", "Account"); err != nil || statement != nil {
+		t.Fatalf("Expected no statement in an empty policy, got %#v, %s", statement, err)
+	}
+}
+
+func TestValidateCloudWatchEventPermissionPrincipal(t *testing.T) {
+	for _, v := range []string{"*", "123456789012"} {
+		if _, errors := validateCloudWatchEventPermissionPrincipal(v, "principal"); len(errors) != 0 {
+			t.Fatalf("%q should be a valid principal: %q", v, errors)
+		}
+	}
+
+	for _, v := range []string{"", "12345678901", "1234567890123", "arn:aws:iam::123456789012:root"} {
+		if _, errors := validateCloudWatchEventPermissionPrincipal(v, "principal"); len(errors) == 0 {
+			t.Fatalf("%q should be an invalid principal", v)
+		}
+	}
+}
+
+func TestAccAWSCloudWatchEventPermission_basic(t *testing.T) {
+	resourceName := "aws_cloudwatch_event_permission.test"
+	statementID := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudWatchEventPermissionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckAwsCloudWatchEventPermissionConfig("12345678901", statementID),
+				ExpectError: regexp.MustCompile(`must be \* or a 12 digit AWS account ID`),
+			},
+			{
+				Config: testAccCheckAwsCloudWatchEventPermissionConfig("*", statementID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchEventPermissionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "action", "events:PutEvents"),
+					resource.TestCheckResourceAttr(resourceName, "principal", "*"),
+					resource.TestCheckResourceAttr(resourceName, "statement_id", statementID),
+				),
+			},
+			{
+				Config: testAccCheckAwsCloudWatchEventPermissionConfig("111111111111", statementID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchEventPermissionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "principal", "111111111111"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCloudWatchEventPermissionExists(pr string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[pr]
+		if !ok {
+			return fmt.Errorf("Not found: %s", pr)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn
+		output, err := conn.DescribeEventBus(&events.DescribeEventBusInput{})
+		if err != nil {
+			return err
+		}
+
+		statement, err := findCloudWatchEventPermissionPolicyStatement(aws.StringValue(output.Policy), rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if statement == nil {
+			return fmt.Errorf("CloudWatch Events permission %q not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckCloudWatchEventPermissionDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cloudwatch_event_permission" {
+			continue
+		}
+
+		output, err := conn.DescribeEventBus(&events.DescribeEventBusInput{})
+		if err != nil {
+			return err
+		}
+
+		statement, err := findCloudWatchEventPermissionPolicyStatement(aws.StringValue(output.Policy), rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if statement != nil {
+			return fmt.Errorf("CloudWatch Events permission %q still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsCloudWatchEventPermissionConfig(principal, statementID string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_event_permission" "test" {
+  principal    = "%s"
+  statement_id = "%s"
+}
+`, principal, statementID)
+}
@@ -14,6 +14,8 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
+const cloudWatchEventRuleStateEnabledWithAllCloudTrailManagementEvents = "ENABLED_WITH_ALL_CLOUDTRAIL_MANAGEMENT_EVENTS"
+
 func resourceAwsCloudWatchEventRule() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsCloudWatchEventRuleCreate,
@@ -34,16 +36,13 @@ func resourceAwsCloudWatchEventRule() *schema.Resource {
 			"schedule_expression": &schema.Schema{
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: validateMaxLength(256),
+				ValidateFunc: validateCloudWatchEventScheduleExpression,
 			},
 			"event_pattern": &schema.Schema{
 				Type:         schema.TypeString,
 				Optional:     true,
 				ValidateFunc: validateEventPatternValue(2048),
-				StateFunc: func(v interface{}) string {
-					json, _ := normalizeJsonString(v)
-					return json
-				},
+				StateFunc:    normalizeJsonStateFunc,
 			},
 			"description": &schema.Schema{
 				Type:         schema.TypeString,
@@ -245,9 +244,11 @@ func buildPutRuleInputStruct(d *schema.ResourceData) (*events.PutRuleInput, erro
 	return &input, nil
 }
 
-// State is represented as (ENABLED|DISABLED) in the API
+// State is represented as (ENABLED|DISABLED) in the API. Rules created
+// outside of Terraform may also be in the ENABLED_WITH_ALL_CLOUDTRAIL_MANAGEMENT_EVENTS
+// state, which is treated as enabled.
 func getBooleanStateFromString(state string) (bool, error) {
-	if state == "ENABLED" {
+	if state == "ENABLED" || state == cloudWatchEventRuleStateEnabledWithAllCloudTrailManagementEvents {
 		return true, nil
 	} else if state == "DISABLED" {
 		return false, nil
@@ -40,9 +40,10 @@ func resourceAwsCloudWatchLogGroup() *schema.Resource {
 			},
 
 			"retention_in_days": {
-				Type:     schema.TypeInt,
-				Optional: true,
-				Default:  0,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validateCloudWatchLogRetentionInDays,
 			},
 
 			"kms_key_id": {
@@ -50,6 +51,12 @@ func resourceAwsCloudWatchLogGroup() *schema.Resource {
 				Optional: true,
 			},
 
+			"skip_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"arn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -258,6 +265,13 @@ func diffCloudWatchTags(oldTags map[string]interface{}, newTags map[string]inter
 
 func resourceAwsCloudWatchLogGroupDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).cloudwatchlogsconn
+
+	if d.Get("skip_destroy").(bool) {
+		log.Printf("[WARN] Removing CloudWatch Log Group %q from state with `skip_destroy` set. Please delete this log group manually.", d.Id())
+		d.SetId("")
+		return nil
+	}
+
 	log.Printf("[INFO] Deleting CloudWatch Log Group: %s", d.Id())
 	_, err := conn.DeleteLogGroup(&cloudwatchlogs.DeleteLogGroupInput{
 		LogGroupName: aws.String(d.Get("name").(string)),
@@ -5,6 +5,7 @@ import (
 	"regexp"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
@@ -246,6 +247,49 @@ func TestAccAWSCloudWatchLogGroup_kmsKey(t *testing.T) {
 					resource.TestCheckResourceAttrSet("aws_cloudwatch_log_group.foobar", "kms_key_id"),
 				),
 			},
+			{
+				Config: testAccAWSCloudWatchLogGroupConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchLogGroupExists("aws_cloudwatch_log_group.foobar", &lg),
+					resource.TestCheckResourceAttr("aws_cloudwatch_log_group.foobar", "kms_key_id", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudWatchLogGroup_invalidRetention(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudWatchLogGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSCloudWatchLogGroupConfig_invalidRetention(rInt),
+				ExpectError: regexp.MustCompile(`"retention_in_days" must be one of`),
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudWatchLogGroup_skipDestroy(t *testing.T) {
+	var lg cloudwatchlogs.LogGroup
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudWatchLogGroupNoDestroy(&lg),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudWatchLogGroupConfig_skipDestroy(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchLogGroupExists("aws_cloudwatch_log_group.foobar", &lg),
+					resource.TestCheckResourceAttr("aws_cloudwatch_log_group.foobar", "skip_destroy", "true"),
+				),
+			},
 		},
 	})
 }
@@ -306,6 +350,25 @@ func testAccCheckAWSCloudWatchLogGroupDestroy(s *terraform.State) error {
 	return nil
 }
 
+// testAccCheckAWSCloudWatchLogGroupNoDestroy checks that the log group
+// survived the destroy and then deletes it.
+func testAccCheckAWSCloudWatchLogGroupNoDestroy(lg *cloudwatchlogs.LogGroup) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).cloudwatchlogsconn
+		name := aws.StringValue(lg.LogGroupName)
+
+		_, exists, err := lookupCloudWatchLogGroup(conn, name, nil)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Bad: LogGroup %q was destroyed despite skip_destroy", name)
+		}
+
+		return testAccCheckCloudWatchLogGroupDisappears(lg)(s)
+	}
+}
+
 func testAccAWSCloudWatchLogGroupConfig(rInt int) string {
 	return fmt.Sprintf(`
 resource "aws_cloudwatch_log_group" "foobar" {
@@ -389,6 +452,24 @@ resource "aws_cloudwatch_log_group" "foobar" {
 `, rInt)
 }
 
+func testAccAWSCloudWatchLogGroupConfig_invalidRetention(rInt int) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_log_group" "foobar" {
+  name              = "foo-bar-%d"
+  retention_in_days = 10
+}
+`, rInt)
+}
+
+func testAccAWSCloudWatchLogGroupConfig_skipDestroy(rInt int) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_log_group" "foobar" {
+  name         = "foo-bar-%d"
+  skip_destroy = true
+}
+`, rInt)
+}
+
 func testAccAWSCloudWatchLogGroupConfig_multiple(rInt int) string {
 	return fmt.Sprintf(`
 resource "aws_cloudwatch_log_group" "alpha" {
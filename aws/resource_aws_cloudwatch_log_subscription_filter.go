@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func resourceAwsCloudwatchLogSubscriptionFilter() *schema.Resource {
@@ -48,6 +49,15 @@ func resourceAwsCloudwatchLogSubscriptionFilter() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"distribution": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  cloudwatchlogs.DistributionByLogStream,
+				ValidateFunc: validation.StringInSlice([]string{
+					cloudwatchlogs.DistributionRandom,
+					cloudwatchlogs.DistributionByLogStream,
+				}, false),
+			},
 		},
 	}
 }
@@ -121,6 +131,10 @@ func getAwsCloudWatchLogsSubscriptionFilterInput(d *schema.ResourceData) cloudwa
 		params.RoleArn = aws.String(d.Get("role_arn").(string))
 	}
 
+	if v, ok := d.GetOk("distribution"); ok {
+		params.Distribution = aws.String(v.(string))
+	}
+
 	return params
 }
 
@@ -148,6 +162,9 @@ func resourceAwsCloudwatchLogSubscriptionFilterRead(d *schema.ResourceData, meta
 	for _, subscriptionFilter := range resp.SubscriptionFilters {
 		if *subscriptionFilter.LogGroupName == log_group_name {
 			d.SetId(cloudwatchLogsSubscriptionFilterId(log_group_name))
+			if subscriptionFilter.Distribution != nil {
+				d.Set("distribution", subscriptionFilter.Distribution)
+			}
 			return nil // OK, matching subscription filter found
 		}
 	}
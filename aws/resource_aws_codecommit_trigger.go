@@ -103,11 +103,22 @@ func resourceAwsCodeCommitTriggerRead(d *schema.ResourceData, meta interface{})
 
 	resp, err := conn.GetRepositoryTriggers(input)
 	if err != nil {
+		if isAWSErr(err, codecommit.ErrCodeRepositoryDoesNotExistException, "") {
+			log.Printf("[WARN] CodeCommit Repository (%s) not found, removing trigger from state", d.Id())
+			d.SetId("")
+			return nil
+		}
 		return fmt.Errorf("Error reading CodeCommit Trigger: %s", err.Error())
 	}
 
 	log.Printf("[DEBUG] CodeCommit Trigger: %s", resp)
 
+	if len(resp.Triggers) == 0 {
+		log.Printf("[WARN] CodeCommit Trigger (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
 	return nil
 }
 
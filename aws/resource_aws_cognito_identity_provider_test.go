@@ -0,0 +1,147 @@
+package aws
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSCognitoIdentityProvider_basic(t *testing.T) {
+	userPoolName := fmt.Sprintf("tf-acc-cognito-user-pool-%s", acctest.RandString(7))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCognitoIdentityProviderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCognitoIdentityProviderConfig_basic(userPoolName, "test-url"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSCognitoIdentityProviderExists("aws_cognito_identity_provider.tf_test_provider"),
+					resource.TestCheckResourceAttr("aws_cognito_identity_provider.tf_test_provider", "provider_name", "Google"),
+					resource.TestCheckResourceAttr("aws_cognito_identity_provider.tf_test_provider", "provider_type", "Google"),
+					resource.TestCheckResourceAttr("aws_cognito_identity_provider.tf_test_provider", "provider_details.client_id", "test-url.apps.googleusercontent.com"),
+					resource.TestCheckResourceAttr("aws_cognito_identity_provider.tf_test_provider", "attribute_mapping.username", "sub"),
+				),
+			},
+			{
+				Config: testAccAWSCognitoIdentityProviderConfig_basic(userPoolName, "test-url-updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSCognitoIdentityProviderExists("aws_cognito_identity_provider.tf_test_provider"),
+					resource.TestCheckResourceAttr("aws_cognito_identity_provider.tf_test_provider", "provider_details.client_id", "test-url-updated.apps.googleusercontent.com"),
+				),
+			},
+			{
+				ResourceName:            "aws_cognito_identity_provider.tf_test_provider",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"provider_details"},
+			},
+		},
+	})
+}
+
+func TestDecodeCognitoIdentityProviderID(t *testing.T) {
+	userPoolID, providerName, err := decodeCognitoIdentityProviderID("us-west-2_aaaaaaaaa:Google")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if userPoolID != "us-west-2_aaaaaaaaa" || providerName != "Google" {
+		t.Fatalf("Unexpected result: %q, %q", userPoolID, providerName)
+	}
+
+	for _, id := range []string{"us-west-2_aaaaaaaaa", "us-west-2_aaaaaaaaa:", ":Google"} {
+		if _, _, err := decodeCognitoIdentityProviderID(id); err == nil {
+			t.Errorf("Expected an error decoding %q", id)
+		}
+	}
+}
+
+func testAccCheckAWSCognitoIdentityProviderDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cognito_identity_provider" {
+			continue
+		}
+
+		userPoolID, providerName, err := decodeCognitoIdentityProviderID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.DescribeIdentityProvider(&cognitoidentityprovider.DescribeIdentityProviderInput{
+			ProviderName: aws.String(providerName),
+			UserPoolId:   aws.String(userPoolID),
+		})
+		if err != nil {
+			if isAWSErr(err, cognitoidentityprovider.ErrCodeResourceNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		return fmt.Errorf("Cognito Identity Provider %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSCognitoIdentityProviderExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No Cognito Identity Provider ID set")
+		}
+
+		userPoolID, providerName, err := decodeCognitoIdentityProviderID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn
+
+		_, err = conn.DescribeIdentityProvider(&cognitoidentityprovider.DescribeIdentityProviderInput{
+			ProviderName: aws.String(providerName),
+			UserPoolId:   aws.String(userPoolID),
+		})
+
+		return err
+	}
+}
+
+func testAccAWSCognitoIdentityProviderConfig_basic(userPoolName, clientId string) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_user_pool" "tf_test_pool" {
+  name                     = "%s"
+  auto_verified_attributes = ["email"]
+}
+
+resource "aws_cognito_identity_provider" "tf_test_provider" {
+  user_pool_id  = "${aws_cognito_user_pool.tf_test_pool.id}"
+  provider_name = "Google"
+  provider_type = "Google"
+
+  provider_details {
+    authorize_scopes = "email"
+    client_id        = "%s.apps.googleusercontent.com"
+    client_secret    = "client_secret"
+  }
+
+  attribute_mapping {
+    email    = "email"
+    username = "sub"
+  }
+}
+`, userPoolName, clientId)
+}
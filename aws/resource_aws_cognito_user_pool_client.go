@@ -0,0 +1,320 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsCognitoUserPoolClient() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCognitoUserPoolClientCreate,
+		Read:   resourceAwsCognitoUserPoolClientRead,
+		Update: resourceAwsCognitoUserPoolClientUpdate,
+		Delete: resourceAwsCognitoUserPoolClientDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsCognitoUserPoolClientImport,
+		},
+
+		// https://docs.aws.amazon.com/cognito-user-identity-pools/latest/APIReference/API_CreateUserPoolClient.html
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+
+			"user_pool_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"generate_secret": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"client_secret": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"refresh_token_validity": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      30,
+				ValidateFunc: validation.IntBetween(0, 3650),
+			},
+
+			"explicit_auth_flows": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						cognitoidentityprovider.ExplicitAuthFlowsTypeAdminNoSrpAuth,
+						cognitoidentityprovider.ExplicitAuthFlowsTypeCustomAuthFlowOnly,
+					}, false),
+				},
+			},
+
+			"read_attributes": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"write_attributes": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"allowed_oauth_flows": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 3,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						cognitoidentityprovider.OAuthFlowTypeCode,
+						cognitoidentityprovider.OAuthFlowTypeImplicit,
+						cognitoidentityprovider.OAuthFlowTypeClientCredentials,
+					}, false),
+				},
+			},
+
+			"allowed_oauth_flows_user_pool_client": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"allowed_oauth_scopes": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 25,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"callback_urls": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 100,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 1024),
+				},
+			},
+
+			"default_redirect_uri": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+
+			"logout_urls": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 100,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 1024),
+				},
+			},
+
+			"supported_identity_providers": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceAwsCognitoUserPoolClientCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	params := &cognitoidentityprovider.CreateUserPoolClientInput{
+		ClientName:           aws.String(d.Get("name").(string)),
+		UserPoolId:           aws.String(d.Get("user_pool_id").(string)),
+		GenerateSecret:       aws.Bool(d.Get("generate_secret").(bool)),
+		RefreshTokenValidity: aws.Int64(int64(d.Get("refresh_token_validity").(int))),
+	}
+
+	if v, ok := d.GetOk("explicit_auth_flows"); ok {
+		params.ExplicitAuthFlows = expandStringSet(v.(*schema.Set))
+	}
+	if v, ok := d.GetOk("read_attributes"); ok {
+		params.ReadAttributes = expandStringSet(v.(*schema.Set))
+	}
+	if v, ok := d.GetOk("write_attributes"); ok {
+		params.WriteAttributes = expandStringSet(v.(*schema.Set))
+	}
+	if v, ok := d.GetOk("allowed_oauth_flows"); ok {
+		params.AllowedOAuthFlows = expandStringSet(v.(*schema.Set))
+	}
+	if v, ok := d.GetOk("allowed_oauth_flows_user_pool_client"); ok {
+		params.AllowedOAuthFlowsUserPoolClient = aws.Bool(v.(bool))
+	}
+	if v, ok := d.GetOk("allowed_oauth_scopes"); ok {
+		params.AllowedOAuthScopes = expandStringSet(v.(*schema.Set))
+	}
+	if v, ok := d.GetOk("callback_urls"); ok {
+		params.CallbackURLs = expandStringList(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("default_redirect_uri"); ok {
+		params.DefaultRedirectURI = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("logout_urls"); ok {
+		params.LogoutURLs = expandStringList(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("supported_identity_providers"); ok {
+		params.SupportedIdentityProviders = expandStringList(v.([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating Cognito User Pool Client: %s", params)
+
+	resp, err := conn.CreateUserPoolClient(params)
+	if err != nil {
+		return fmt.Errorf("Error creating Cognito User Pool Client: %s", err)
+	}
+
+	d.SetId(aws.StringValue(resp.UserPoolClient.ClientId))
+
+	return resourceAwsCognitoUserPoolClientRead(d, meta)
+}
+
+func resourceAwsCognitoUserPoolClientRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	params := &cognitoidentityprovider.DescribeUserPoolClientInput{
+		ClientId:   aws.String(d.Id()),
+		UserPoolId: aws.String(d.Get("user_pool_id").(string)),
+	}
+
+	log.Printf("[DEBUG] Reading Cognito User Pool Client: %s", params)
+
+	resp, err := conn.DescribeUserPoolClient(params)
+	if err != nil {
+		if isAWSErr(err, cognitoidentityprovider.ErrCodeResourceNotFoundException, "") {
+			log.Printf("[WARN] Cognito User Pool Client %s is already gone", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading Cognito User Pool Client (%s): %s", d.Id(), err)
+	}
+
+	client := resp.UserPoolClient
+
+	d.Set("name", client.ClientName)
+	d.Set("user_pool_id", client.UserPoolId)
+	d.Set("client_secret", client.ClientSecret)
+	d.Set("generate_secret", client.ClientSecret != nil)
+	d.Set("refresh_token_validity", client.RefreshTokenValidity)
+	d.Set("allowed_oauth_flows_user_pool_client", client.AllowedOAuthFlowsUserPoolClient)
+	d.Set("default_redirect_uri", client.DefaultRedirectURI)
+
+	if err := d.Set("explicit_auth_flows", flattenStringList(client.ExplicitAuthFlows)); err != nil {
+		return fmt.Errorf("Error setting explicit_auth_flows: %s", err)
+	}
+	if err := d.Set("read_attributes", flattenStringList(client.ReadAttributes)); err != nil {
+		return fmt.Errorf("Error setting read_attributes: %s", err)
+	}
+	if err := d.Set("write_attributes", flattenStringList(client.WriteAttributes)); err != nil {
+		return fmt.Errorf("Error setting write_attributes: %s", err)
+	}
+	if err := d.Set("allowed_oauth_flows", flattenStringList(client.AllowedOAuthFlows)); err != nil {
+		return fmt.Errorf("Error setting allowed_oauth_flows: %s", err)
+	}
+	if err := d.Set("allowed_oauth_scopes", flattenStringList(client.AllowedOAuthScopes)); err != nil {
+		return fmt.Errorf("Error setting allowed_oauth_scopes: %s", err)
+	}
+	if err := d.Set("callback_urls", flattenStringList(client.CallbackURLs)); err != nil {
+		return fmt.Errorf("Error setting callback_urls: %s", err)
+	}
+	if err := d.Set("logout_urls", flattenStringList(client.LogoutURLs)); err != nil {
+		return fmt.Errorf("Error setting logout_urls: %s", err)
+	}
+	if err := d.Set("supported_identity_providers", flattenStringList(client.SupportedIdentityProviders)); err != nil {
+		return fmt.Errorf("Error setting supported_identity_providers: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsCognitoUserPoolClientUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	// UpdateUserPoolClient resets every attribute left out of the request
+	// to its default, so the full configuration is always sent.
+	params := &cognitoidentityprovider.UpdateUserPoolClientInput{
+		ClientId:                        aws.String(d.Id()),
+		ClientName:                      aws.String(d.Get("name").(string)),
+		UserPoolId:                      aws.String(d.Get("user_pool_id").(string)),
+		RefreshTokenValidity:            aws.Int64(int64(d.Get("refresh_token_validity").(int))),
+		AllowedOAuthFlowsUserPoolClient: aws.Bool(d.Get("allowed_oauth_flows_user_pool_client").(bool)),
+		ExplicitAuthFlows:               expandStringSet(d.Get("explicit_auth_flows").(*schema.Set)),
+		ReadAttributes:                  expandStringSet(d.Get("read_attributes").(*schema.Set)),
+		WriteAttributes:                 expandStringSet(d.Get("write_attributes").(*schema.Set)),
+		AllowedOAuthFlows:               expandStringSet(d.Get("allowed_oauth_flows").(*schema.Set)),
+		AllowedOAuthScopes:              expandStringSet(d.Get("allowed_oauth_scopes").(*schema.Set)),
+		CallbackURLs:                    expandStringList(d.Get("callback_urls").([]interface{})),
+		LogoutURLs:                      expandStringList(d.Get("logout_urls").([]interface{})),
+		SupportedIdentityProviders:      expandStringList(d.Get("supported_identity_providers").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("default_redirect_uri"); ok {
+		params.DefaultRedirectURI = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Updating Cognito User Pool Client: %s", params)
+
+	_, err := conn.UpdateUserPoolClient(params)
+	if err != nil {
+		return fmt.Errorf("Error updating Cognito User Pool Client (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsCognitoUserPoolClientRead(d, meta)
+}
+
+func resourceAwsCognitoUserPoolClientDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	params := &cognitoidentityprovider.DeleteUserPoolClientInput{
+		ClientId:   aws.String(d.Id()),
+		UserPoolId: aws.String(d.Get("user_pool_id").(string)),
+	}
+
+	log.Printf("[DEBUG] Deleting Cognito User Pool Client: %s", params)
+
+	_, err := conn.DeleteUserPoolClient(params)
+	if err != nil {
+		if isAWSErr(err, cognitoidentityprovider.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Cognito User Pool Client (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsCognitoUserPoolClientImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected USER-POOL-ID/CLIENT-ID", d.Id())
+	}
+
+	d.Set("user_pool_id", parts[0])
+	d.SetId(parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
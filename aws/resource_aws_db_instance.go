@@ -25,6 +25,8 @@ func resourceAwsDbInstance() *schema.Resource {
 			State: resourceAwsDbInstanceImport,
 		},
 
+		CustomizeDiff: customizeDiffKmsKeyId("kms_key_id"),
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(40 * time.Minute),
 			Update: schema.DefaultTimeout(80 * time.Minute),
@@ -325,7 +327,7 @@ func resourceAwsDbInstance() *schema.Resource {
 				Optional:     true,
 				Computed:     true,
 				ForceNew:     true,
-				ValidateFunc: validateArn,
+				ValidateFunc: validateKmsKey,
 			},
 
 			"timezone": {
@@ -733,7 +735,7 @@ func resourceAwsDbInstanceRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("maintenance_window", v.PreferredMaintenanceWindow)
 	d.Set("publicly_accessible", v.PubliclyAccessible)
 	d.Set("multi_az", v.MultiAZ)
-	d.Set("kms_key_id", v.KmsKeyId)
+	d.Set("kms_key_id", kmsKeyIdStateValue(d.Get("kms_key_id").(string), aws.StringValue(v.KmsKeyId)))
 	d.Set("port", v.DbInstancePort)
 	d.Set("iam_database_authentication_enabled", v.IAMDatabaseAuthenticationEnabled)
 	if v.DBSubnetGroup != nil {
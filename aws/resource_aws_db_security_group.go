@@ -142,6 +142,11 @@ func resourceAwsDbSecurityGroupCreate(d *schema.ResourceData, meta interface{})
 
 func resourceAwsDbSecurityGroupRead(d *schema.ResourceData, meta interface{}) error {
 	sg, err := resourceAwsDbSecurityGroupRetrieve(d, meta)
+	if isAWSErr(err, rds.ErrCodeDBSecurityGroupNotFoundFault, "") {
+		log.Printf("[WARN] DB Security Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
 	if err != nil {
 		return err
 	}
@@ -288,6 +293,9 @@ func resourceAwsDbSecurityGroupRetrieve(d *schema.ResourceData, meta interface{}
 	resp, err := conn.DescribeDBSecurityGroups(&opts)
 
 	if err != nil {
+		if isAWSErr(err, rds.ErrCodeDBSecurityGroupNotFoundFault, "") {
+			return nil, err
+		}
 		return nil, fmt.Errorf("Error retrieving DB Security Groups: %s", err)
 	}
 
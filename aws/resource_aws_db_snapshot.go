@@ -146,9 +146,20 @@ func resourceAwsDbSnapshotRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	resp, err := conn.DescribeDBSnapshots(params)
 	if err != nil {
+		if isAWSErr(err, rds.ErrCodeDBSnapshotNotFoundFault, "") {
+			log.Printf("[WARN] DB Snapshot (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
 		return err
 	}
 
+	if len(resp.DBSnapshots) == 0 {
+		log.Printf("[WARN] DB Snapshot (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
 	snapshot := resp.DBSnapshots[0]
 
 	d.Set("allocated_storage", snapshot.AllocatedStorage)
@@ -66,6 +66,11 @@ func resourceAwsDevicefarmProjectRead(d *schema.ResourceData, meta interface{})
 	log.Printf("[DEBUG] Reading DeviceFarm Project: %s", d.Id())
 	out, err := conn.GetProject(input)
 	if err != nil {
+		if isAWSErr(err, devicefarm.ErrCodeNotFoundException, "") {
+			log.Printf("[WARN] DeviceFarm Project (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
 		return fmt.Errorf("Error reading DeviceFarm Project: %s", err)
 	}
 
@@ -25,6 +25,8 @@ func resourceAwsEbsVolume() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: customizeDiffKmsKeyId("kms_key_id"),
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -51,7 +53,7 @@ func resourceAwsEbsVolume() *schema.Resource {
 				Optional:     true,
 				Computed:     true,
 				ForceNew:     true,
-				ValidateFunc: validateArn,
+				ValidateFunc: validateKmsKey,
 			},
 			"size": {
 				Type:     schema.TypeInt,
@@ -84,7 +86,13 @@ func resourceAwsEbsVolumeCreate(d *schema.ResourceData, meta interface{}) error
 		request.Encrypted = aws.Bool(value.(bool))
 	}
 	if value, ok := d.GetOk("kms_key_id"); ok {
-		request.KmsKeyId = aws.String(value.(string))
+		// CreateVolume only accepts key ARNs, aliases and key IDs are
+		// resolved beforehand.
+		arn, err := resolveKmsKeyArn(meta.(*AWSClient).kmsconn, value.(string))
+		if err != nil {
+			return err
+		}
+		request.KmsKeyId = aws.String(arn)
 	}
 	if value, ok := d.GetOk("size"); ok {
 		request.Size = aws.Int64(int64(value.(int)))
@@ -289,7 +297,7 @@ func readVolume(d *schema.ResourceData, client *AWSClient, volume *ec2.Volume) e
 		d.Set("encrypted", *volume.Encrypted)
 	}
 	if volume.KmsKeyId != nil {
-		d.Set("kms_key_id", *volume.KmsKeyId)
+		d.Set("kms_key_id", kmsKeyIdStateValue(d.Get("kms_key_id").(string), *volume.KmsKeyId))
 	}
 	if volume.Size != nil {
 		d.Set("size", *volume.Size)
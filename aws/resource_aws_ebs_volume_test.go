@@ -154,6 +154,38 @@ func TestAccAWSEBSVolume_kmsKey(t *testing.T) {
 	})
 }
 
+func TestAccAWSEBSVolume_kmsKeyAlias(t *testing.T) {
+	var v1, v2 ec2.Volume
+	ri := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsEbsVolumeConfigWithKmsKeyAlias(ri, "${aws_kms_key.foo.arn}"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVolumeExists("aws_ebs_volume.test", &v1),
+					resource.TestCheckResourceAttrPair("aws_ebs_volume.test", "kms_key_id", "aws_kms_key.foo", "arn"),
+				),
+			},
+			{
+				// Switching to an alias of the same key must not replace the volume.
+				Config: testAccAwsEbsVolumeConfigWithKmsKeyAlias(ri, "${aws_kms_alias.foo.name}"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVolumeExists("aws_ebs_volume.test", &v2),
+					func(s *terraform.State) error {
+						if aws.StringValue(v1.VolumeId) != aws.StringValue(v2.VolumeId) {
+							return fmt.Errorf("EBS Volume was recreated")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSEBSVolume_NoIops(t *testing.T) {
 	var v ec2.Volume
 	resource.Test(t, resource.TestCase{
@@ -420,6 +452,26 @@ resource "aws_ebs_volume" "test" {
 }
 `
 
+func testAccAwsEbsVolumeConfigWithKmsKeyAlias(rInt int, kmsKeyId string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "foo" {
+  description = "Terraform acc test %d"
+}
+
+resource "aws_kms_alias" "foo" {
+  name          = "alias/tf-acc-test-ebs-%d"
+  target_key_id = "${aws_kms_key.foo.key_id}"
+}
+
+resource "aws_ebs_volume" "test" {
+  availability_zone = "us-west-2a"
+  size              = 1
+  encrypted         = true
+  kms_key_id        = "%s"
+}
+`, rInt, rInt, kmsKeyId)
+}
+
 const testAccAwsEbsVolumeConfigWithTags = `
 resource "aws_ebs_volume" "tags_test" {
   availability_zone = "us-west-2a"
@@ -45,13 +45,10 @@ func resourceAwsEcsTaskDefinition() *schema.Resource {
 			},
 
 			"container_definitions": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
-				StateFunc: func(v interface{}) string {
-					json, _ := normalizeJsonString(v)
-					return json
-				},
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				StateFunc: normalizeJsonStateFunc,
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 					equal, _ := ecsContainerDefinitionsAreEquivalent(old, new)
 					return equal
@@ -251,6 +248,11 @@ func resourceAwsEcsTaskDefinitionRead(d *schema.ResourceData, meta interface{})
 		TaskDefinition: aws.String(d.Get("arn").(string)),
 	})
 	if err != nil {
+		if isAWSErr(err, ecs.ErrCodeClientException, "Unable to describe task definition") {
+			log.Printf("[WARN] ECS Task Definition (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
 		return err
 	}
 	log.Printf("[DEBUG] Received task definition %s", out)
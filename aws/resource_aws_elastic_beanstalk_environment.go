@@ -8,16 +8,23 @@ import (
 	"strings"
 	"time"
 
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform/helper/hashcode"
-	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/elasticbeanstalk/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/elasticbeanstalk/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
 )
 
+// elasticBeanstalkLoadBalancerTypeClassic is the LoadBalancerType option value
+// of environments with a classic load balancer.
+const elasticBeanstalkLoadBalancerTypeClassic = "classic"
+
 func resourceAwsElasticBeanstalkOptionSetting() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
@@ -177,6 +184,14 @@ func resourceAwsElasticBeanstalkEnvironment() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"load_balancer_arn": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"load_balancer_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"queues": &schema.Schema{
 				Type:     schema.TypeList,
 				Computed: true,
@@ -278,24 +293,14 @@ func resourceAwsElasticBeanstalkEnvironmentCreate(d *schema.ResourceData, meta i
 		log.Printf("[WARN] Error parsing poll_interval, using default backoff")
 	}
 
-	stateConf := &resource.StateChangeConf{
-		Pending:      []string{"Launching", "Updating"},
-		Target:       []string{"Ready"},
-		Refresh:      environmentStateRefreshFunc(conn, d.Id(), t),
-		Timeout:      waitForReadyTimeOut,
-		Delay:        10 * time.Second,
-		PollInterval: pollInterval,
-		MinTimeout:   3 * time.Second,
-	}
-
-	_, err = stateConf.WaitForState()
+	_, err = waiter.EnvironmentReady(conn, d.Id(), t, waitForReadyTimeOut, pollInterval)
 	if err != nil {
 		return fmt.Errorf(
 			"Error waiting for Elastic Beanstalk Environment (%s) to become ready: %s",
 			d.Id(), err)
 	}
 
-	envErrors, err := getBeanstalkEnvironmentErrors(conn, d.Id(), t)
+	envErrors, err := finder.EnvironmentErrors(conn, d.Id(), t)
 	if err != nil {
 		return err
 	}
@@ -407,10 +412,15 @@ func resourceAwsElasticBeanstalkEnvironmentUpdate(d *schema.ResourceData, meta i
 	}
 
 	if hasChange {
+		// Environments of the same application are updated one at a time, as
+		// concurrent updates fail with OperationInProgress errors.
+		unlock := lockMutation(meta, awsMutexElasticBeanstalkApplicationKey(d.Get("application").(string)))
+
 		// Get the current time to filter getBeanstalkEnvironmentErrors messages
 		t := time.Now()
 		log.Printf("[DEBUG] Elastic Beanstalk Environment update opts: %s", updateOpts)
 		_, err := conn.UpdateEnvironment(&updateOpts)
+		unlock()
 		if err != nil {
 			return err
 		}
@@ -425,24 +435,14 @@ func resourceAwsElasticBeanstalkEnvironmentUpdate(d *schema.ResourceData, meta i
 			log.Printf("[WARN] Error parsing poll_interval, using default backoff")
 		}
 
-		stateConf := &resource.StateChangeConf{
-			Pending:      []string{"Launching", "Updating"},
-			Target:       []string{"Ready"},
-			Refresh:      environmentStateRefreshFunc(conn, d.Id(), t),
-			Timeout:      waitForReadyTimeOut,
-			Delay:        10 * time.Second,
-			PollInterval: pollInterval,
-			MinTimeout:   3 * time.Second,
-		}
-
-		_, err = stateConf.WaitForState()
+		_, err = waiter.EnvironmentReady(conn, d.Id(), t, waitForReadyTimeOut, pollInterval)
 		if err != nil {
 			return fmt.Errorf(
 				"Error waiting for Elastic Beanstalk Environment (%s) to become ready: %s",
 				d.Id(), err)
 		}
 
-		envErrors, err := getBeanstalkEnvironmentErrors(conn, d.Id(), t)
+		envErrors, err := finder.EnvironmentErrors(conn, d.Id(), t)
 		if err != nil {
 			return err
 		}
@@ -461,24 +461,18 @@ func resourceAwsElasticBeanstalkEnvironmentRead(d *schema.ResourceData, meta int
 
 	log.Printf("[DEBUG] Elastic Beanstalk environment read %s: id %s", d.Get("name").(string), d.Id())
 
-	resp, err := conn.DescribeEnvironments(&elasticbeanstalk.DescribeEnvironmentsInput{
-		EnvironmentIds: []*string{aws.String(envId)},
-	})
-
-	if err != nil {
-		return err
-	}
+	env, err := finder.EnvironmentByID(conn, envId)
 
-	if len(resp.Environments) == 0 {
-		log.Printf("[DEBUG] Elastic Beanstalk environment properties: could not find environment %s", d.Id())
+	if tfresource.NotFound(err) {
+		log.Printf("[WARN] Elastic Beanstalk environment (%s) not found, removing from state", d.Id())
 
 		d.SetId("")
 		return nil
-	} else if len(resp.Environments) != 1 {
-		return fmt.Errorf("Error reading application properties: found %d environments, expected 1", len(resp.Environments))
 	}
 
-	env := resp.Environments[0]
+	if err != nil {
+		return err
+	}
 
 	if *env.Status == "Terminated" {
 		log.Printf("[DEBUG] Elastic Beanstalk environment %s was terminated", d.Id())
@@ -556,6 +550,9 @@ func resourceAwsElasticBeanstalkEnvironmentRead(d *schema.ResourceData, meta int
 	if err := d.Set("load_balancers", flattenBeanstalkElb(resources.EnvironmentResources.LoadBalancers)); err != nil {
 		return err
 	}
+	lbArn, lbType := elasticBeanstalkEnvironmentLoadBalancer(meta.(*AWSClient), resources.EnvironmentResources.LoadBalancers)
+	d.Set("load_balancer_arn", lbArn)
+	d.Set("load_balancer_type", lbType)
 	if err := d.Set("queues", flattenBeanstalkSqs(resources.EnvironmentResources.Queues)); err != nil {
 		return err
 	}
@@ -566,6 +563,29 @@ func resourceAwsElasticBeanstalkEnvironmentRead(d *schema.ResourceData, meta int
 	return resourceAwsElasticBeanstalkEnvironmentSettingsRead(d, meta)
 }
 
+// elasticBeanstalkEnvironmentLoadBalancer returns the ARN and type of an
+// environment's load balancer, or empty strings if it has none. Application
+// and network load balancers are reported by ARN, classic ones only by name.
+func elasticBeanstalkEnvironmentLoadBalancer(client *AWSClient, lbs []*elasticbeanstalk.LoadBalancer) (string, string) {
+	if len(lbs) == 0 || lbs[0].Name == nil {
+		return "", ""
+	}
+
+	name := aws.StringValue(lbs[0].Name)
+
+	if parsed, err := arn.Parse(name); err == nil {
+		switch {
+		case strings.HasPrefix(parsed.Resource, "loadbalancer/app/"):
+			return name, elbv2.LoadBalancerTypeEnumApplication
+		case strings.HasPrefix(parsed.Resource, "loadbalancer/net/"):
+			return name, elbv2.LoadBalancerTypeEnumNetwork
+		}
+		return name, elasticBeanstalkLoadBalancerTypeClassic
+	}
+
+	return arnString(client.partition, client.region, "elasticloadbalancing", client.accountid, fmt.Sprintf("loadbalancer/%s", name)), elasticBeanstalkLoadBalancerTypeClassic
+}
+
 func fetchAwsElasticBeanstalkEnvironmentSettings(d *schema.ResourceData, meta interface{}) (*schema.Set, error) {
 	conn := meta.(*AWSClient).elasticbeanstalkconn
 
@@ -689,24 +709,14 @@ func resourceAwsElasticBeanstalkEnvironmentDelete(d *schema.ResourceData, meta i
 		log.Printf("[WARN] Error parsing poll_interval, using default backoff")
 	}
 
-	stateConf := &resource.StateChangeConf{
-		Pending:      []string{"Terminating"},
-		Target:       []string{"Terminated"},
-		Refresh:      environmentStateRefreshFunc(conn, d.Id(), t),
-		Timeout:      waitForReadyTimeOut,
-		Delay:        10 * time.Second,
-		PollInterval: pollInterval,
-		MinTimeout:   3 * time.Second,
-	}
-
-	_, err = stateConf.WaitForState()
+	_, err = waiter.EnvironmentTerminated(conn, d.Id(), t, waitForReadyTimeOut, pollInterval)
 	if err != nil {
 		return fmt.Errorf(
 			"Error waiting for Elastic Beanstalk Environment (%s) to become terminated: %s",
 			d.Id(), err)
 	}
 
-	envErrors, err := getBeanstalkEnvironmentErrors(conn, d.Id(), t)
+	envErrors, err := finder.EnvironmentErrors(conn, d.Id(), t)
 	if err != nil {
 		return err
 	}
@@ -717,47 +727,6 @@ func resourceAwsElasticBeanstalkEnvironmentDelete(d *schema.ResourceData, meta i
 	return nil
 }
 
-// environmentStateRefreshFunc returns a resource.StateRefreshFunc that is used to watch
-// the creation of the Beanstalk Environment
-func environmentStateRefreshFunc(conn *elasticbeanstalk.ElasticBeanstalk, environmentId string, t time.Time) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		resp, err := conn.DescribeEnvironments(&elasticbeanstalk.DescribeEnvironmentsInput{
-			EnvironmentIds: []*string{aws.String(environmentId)},
-		})
-		if err != nil {
-			log.Printf("[Err] Error waiting for Elastic Beanstalk Environment state: %s", err)
-			return -1, "failed", fmt.Errorf("[Err] Error waiting for Elastic Beanstalk Environment state: %s", err)
-		}
-
-		if resp == nil || len(resp.Environments) == 0 {
-			// Sometimes AWS just has consistency issues and doesn't see
-			// our instance yet. Return an empty state.
-			return nil, "", nil
-		}
-
-		var env *elasticbeanstalk.EnvironmentDescription
-		for _, e := range resp.Environments {
-			if environmentId == *e.EnvironmentId {
-				env = e
-			}
-		}
-
-		if env == nil {
-			return -1, "failed", fmt.Errorf("[Err] Error finding Elastic Beanstalk Environment, environment not found")
-		}
-
-		envErrors, err := getBeanstalkEnvironmentErrors(conn, environmentId, t)
-		if err != nil {
-			return -1, "failed", err
-		}
-		if envErrors != nil {
-			return -1, "failed", envErrors
-		}
-
-		return env, *env.Status, nil
-	}
-}
-
 // we use the following two functions to allow us to split out defaults
 // as they become overridden from within the template
 func optionSettingValueHash(v interface{}) int {
@@ -866,49 +835,3 @@ func dropGeneratedSecurityGroup(settingValue string, meta interface{}) string {
 
 	return strings.Join(legitGroups, ",")
 }
-
-type beanstalkEnvironmentError struct {
-	eventDate     *time.Time
-	environmentID string
-	message       *string
-}
-
-func (e beanstalkEnvironmentError) Error() string {
-	return e.eventDate.String() + " (" + e.environmentID + ") : " + *e.message
-}
-
-type beanstalkEnvironmentErrors []*beanstalkEnvironmentError
-
-func (e beanstalkEnvironmentErrors) Len() int           { return len(e) }
-func (e beanstalkEnvironmentErrors) Swap(i, j int)      { e[i], e[j] = e[j], e[i] }
-func (e beanstalkEnvironmentErrors) Less(i, j int) bool { return e[i].eventDate.Before(*e[j].eventDate) }
-
-func getBeanstalkEnvironmentErrors(conn *elasticbeanstalk.ElasticBeanstalk, environmentId string, t time.Time) (*multierror.Error, error) {
-	environmentErrors, err := conn.DescribeEvents(&elasticbeanstalk.DescribeEventsInput{
-		EnvironmentId: aws.String(environmentId),
-		Severity:      aws.String("ERROR"),
-		StartTime:     aws.Time(t),
-	})
-
-	if err != nil {
-		return nil, fmt.Errorf("[Err] Unable to get Elastic Beanstalk Evironment events: %s", err)
-	}
-
-	var events beanstalkEnvironmentErrors
-	for _, event := range environmentErrors.Events {
-		e := &beanstalkEnvironmentError{
-			eventDate:     event.EventDate,
-			environmentID: environmentId,
-			message:       event.Message,
-		}
-		events = append(events, e)
-	}
-	sort.Sort(beanstalkEnvironmentErrors(events))
-
-	var result *multierror.Error
-	for _, event := range events {
-		result = multierror.Append(result, event)
-	}
-
-	return result, nil
-}
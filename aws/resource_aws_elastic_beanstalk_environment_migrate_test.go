@@ -2,19 +2,13 @@ package aws
 
 import (
 	"testing"
-
-	"github.com/hashicorp/terraform/terraform"
 )
 
 func TestAWSElasticBeanstalkEnvironmentMigrateState(t *testing.T) {
-	cases := map[string]struct {
-		StateVersion int
-		Attributes   map[string]string
-		Expected     map[string]string
-		Meta         interface{}
-	}{
+	testStateMigration(t, resourceAwsElasticBeanstalkEnvironmentMigrateState, map[string]stateMigrationTestCase{
 		"v0_1_web": {
 			StateVersion: 0,
+			ID:           "e-abcde12345",
 			Attributes: map[string]string{
 				"tier": "",
 			},
@@ -24,6 +18,7 @@ func TestAWSElasticBeanstalkEnvironmentMigrateState(t *testing.T) {
 		},
 		"v0_1_web_explicit": {
 			StateVersion: 0,
+			ID:           "e-abcde12345",
 			Attributes: map[string]string{
 				"tier": "WebServer",
 			},
@@ -33,6 +28,7 @@ func TestAWSElasticBeanstalkEnvironmentMigrateState(t *testing.T) {
 		},
 		"v0_1_worker": {
 			StateVersion: 0,
+			ID:           "e-abcde12345",
 			Attributes: map[string]string{
 				"tier": "Worker",
 			},
@@ -40,18 +36,5 @@ func TestAWSElasticBeanstalkEnvironmentMigrateState(t *testing.T) {
 				"tier": "Worker",
 			},
 		},
-	}
-
-	for tn, tc := range cases {
-		is := &terraform.InstanceState{
-			ID:         "e-abcde12345",
-			Attributes: tc.Attributes,
-		}
-		is, err := resourceAwsElasticBeanstalkEnvironmentMigrateState(
-			tc.StateVersion, is, tc.Meta)
-
-		if err != nil {
-			t.Fatalf("bad: %s, err: %#v", tn, err)
-		}
-	}
+	})
 }
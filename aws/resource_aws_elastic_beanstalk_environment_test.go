@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/elasticbeanstalk/waiter"
 )
 
 // initialize sweeper
@@ -87,17 +88,7 @@ func testSweepBeanstalkEnvironments(region string) error {
 
 		// poll for deletion
 		t := time.Now()
-		stateConf := &resource.StateChangeConf{
-			Pending:      []string{"Terminating"},
-			Target:       []string{"Terminated"},
-			Refresh:      environmentStateRefreshFunc(beanstalkconn, *bse.EnvironmentId, t),
-			Timeout:      waitForReadyTimeOut,
-			Delay:        10 * time.Second,
-			PollInterval: pollInterval,
-			MinTimeout:   3 * time.Second,
-		}
-
-		_, err = stateConf.WaitForState()
+		_, err = waiter.EnvironmentTerminated(beanstalkconn, *bse.EnvironmentId, t, waitForReadyTimeOut, pollInterval)
 		if err != nil {
 			return fmt.Errorf(
 				"Error waiting for Elastic Beanstalk Environment (%s) to become terminated: %s",
@@ -114,7 +105,7 @@ func TestAccAWSBeanstalkEnv_basic(t *testing.T) {
 	rInt := acctest.RandInt()
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
+		PreCheck:     func() { testAccPreCheck(t); testAccElasticBeanstalkPreCheck(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckBeanstalkEnvDestroy,
 		Steps: []resource.TestStep{
@@ -134,7 +125,7 @@ func TestAccAWSBeanstalkEnv_tier(t *testing.T) {
 	rInt := acctest.RandInt()
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
+		PreCheck:     func() { testAccPreCheck(t); testAccElasticBeanstalkPreCheck(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckBeanstalkEnvDestroy,
 		Steps: []resource.TestStep{
@@ -159,7 +150,7 @@ func TestAccAWSBeanstalkEnv_outputs(t *testing.T) {
 	beanstalkLcNameRegexp := regexp.MustCompile("awseb.+?AutoScalingLaunch[^,]+")
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
+		PreCheck:     func() { testAccPreCheck(t); testAccElasticBeanstalkPreCheck(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckBeanstalkEnvDestroy,
 		Steps: []resource.TestStep{
@@ -175,12 +166,67 @@ func TestAccAWSBeanstalkEnv_outputs(t *testing.T) {
 						"aws_elastic_beanstalk_environment.tfenvtest", "instances.0", beanstalkInstancesNameRegexp),
 					resource.TestMatchResourceAttr(
 						"aws_elastic_beanstalk_environment.tfenvtest", "launch_configurations.0", beanstalkLcNameRegexp),
+					resource.TestMatchResourceAttr(
+						"aws_elastic_beanstalk_environment.tfenvtest", "load_balancer_arn", regexp.MustCompile("^arn:[^:]+:elasticloadbalancing:[^:]+:[^:]+:loadbalancer/awseb")),
+					resource.TestCheckResourceAttr(
+						"aws_elastic_beanstalk_environment.tfenvtest", "load_balancer_type", "classic"),
 				),
 			},
 		},
 	})
 }
 
+func TestElasticBeanstalkEnvironmentLoadBalancer(t *testing.T) {
+	client := &AWSClient{
+		accountid: "123456789012",
+		partition: "aws",
+		region:    "us-west-2",
+	}
+
+	cases := []struct {
+		LoadBalancers []*elasticbeanstalk.LoadBalancer
+		ExpectedArn   string
+		ExpectedType  string
+	}{
+		{
+			LoadBalancers: nil,
+			ExpectedArn:   "",
+			ExpectedType:  "",
+		},
+		{
+			LoadBalancers: []*elasticbeanstalk.LoadBalancer{
+				{Name: aws.String("awseb-e-a-AWSEBLoa-1ABCDEFGHIJKL")},
+			},
+			ExpectedArn:  "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/awseb-e-a-AWSEBLoa-1ABCDEFGHIJKL",
+			ExpectedType: "classic",
+		},
+		{
+			LoadBalancers: []*elasticbeanstalk.LoadBalancer{
+				{Name: aws.String("arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/awseb-AWSEB-1ABCDEFGHIJKL/0123456789abcdef")},
+			},
+			ExpectedArn:  "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/awseb-AWSEB-1ABCDEFGHIJKL/0123456789abcdef",
+			ExpectedType: "application",
+		},
+		{
+			LoadBalancers: []*elasticbeanstalk.LoadBalancer{
+				{Name: aws.String("arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/net/awseb-AWSEB-1ABCDEFGHIJKL/0123456789abcdef")},
+			},
+			ExpectedArn:  "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/net/awseb-AWSEB-1ABCDEFGHIJKL/0123456789abcdef",
+			ExpectedType: "network",
+		},
+	}
+
+	for _, tc := range cases {
+		lbArn, lbType := elasticBeanstalkEnvironmentLoadBalancer(client, tc.LoadBalancers)
+		if lbArn != tc.ExpectedArn {
+			t.Errorf("Expected ARN %q, got %q", tc.ExpectedArn, lbArn)
+		}
+		if lbType != tc.ExpectedType {
+			t.Errorf("Expected type %q, got %q", tc.ExpectedType, lbType)
+		}
+	}
+}
+
 func TestAccAWSBeanstalkEnv_cname_prefix(t *testing.T) {
 	var app elasticbeanstalk.EnvironmentDescription
 	cnamePrefix := acctest.RandString(8)
@@ -188,7 +234,7 @@ func TestAccAWSBeanstalkEnv_cname_prefix(t *testing.T) {
 	beanstalkCnameRegexp := regexp.MustCompile("^" + cnamePrefix + ".+?elasticbeanstalk.com$")
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
+		PreCheck:     func() { testAccPreCheck(t); testAccElasticBeanstalkPreCheck(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckBeanstalkEnvDestroy,
 		Steps: []resource.TestStep{
@@ -209,7 +255,7 @@ func TestAccAWSBeanstalkEnv_config(t *testing.T) {
 	rInt := acctest.RandInt()
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
+		PreCheck:     func() { testAccPreCheck(t); testAccElasticBeanstalkPreCheck(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckBeanstalkEnvDestroy,
 		Steps: []resource.TestStep{
@@ -245,7 +291,7 @@ func TestAccAWSBeanstalkEnv_resource(t *testing.T) {
 	rInt := acctest.RandInt()
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
+		PreCheck:     func() { testAccPreCheck(t); testAccElasticBeanstalkPreCheck(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckBeanstalkEnvDestroy,
 		Steps: []resource.TestStep{
@@ -266,6 +312,7 @@ func TestAccAWSBeanstalkEnv_vpc(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
 			testAccPreCheck(t)
+			testAccElasticBeanstalkPreCheck(t)
 		},
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckBeanstalkEnvDestroy,
@@ -288,6 +335,7 @@ func TestAccAWSBeanstalkEnv_template_change(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
 			testAccPreCheck(t)
+			testAccElasticBeanstalkPreCheck(t)
 		},
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckBeanstalkEnvDestroy,
@@ -320,7 +368,7 @@ func TestAccAWSBeanstalkEnv_basic_settings_update(t *testing.T) {
 	rInt := acctest.RandInt()
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
+		PreCheck:     func() { testAccPreCheck(t); testAccElasticBeanstalkPreCheck(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckBeanstalkEnvDestroy,
 		Steps: []resource.TestStep{
@@ -360,7 +408,7 @@ func TestAccAWSBeanstalkEnv_version_label(t *testing.T) {
 	var app elasticbeanstalk.EnvironmentDescription
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
+		PreCheck:     func() { testAccPreCheck(t); testAccElasticBeanstalkPreCheck(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckBeanstalkEnvDestroy,
 		Steps: []resource.TestStep{
@@ -384,7 +432,7 @@ func TestAccAWSBeanstalkEnv_settingWithJsonValue(t *testing.T) {
 	var app elasticbeanstalk.EnvironmentDescription
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
+		PreCheck:     func() { testAccPreCheck(t); testAccElasticBeanstalkPreCheck(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckBeanstalkEnvDestroy,
 		Steps: []resource.TestStep{
@@ -102,6 +102,11 @@ func resourceAwsElasticacheParameterGroupRead(d *schema.ResourceData, meta inter
 
 	describeResp, err := conn.DescribeCacheParameterGroups(&describeOpts)
 	if err != nil {
+		if isAWSErr(err, elasticache.ErrCodeCacheParameterGroupNotFoundFault, "") {
+			log.Printf("[WARN] ElastiCache Parameter Group (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
 		return err
 	}
 
@@ -94,10 +94,17 @@ func resourceAwsElasticacheSecurityGroupRead(d *schema.ResourceData, meta interf
 
 	res, err := conn.DescribeCacheSecurityGroups(req)
 	if err != nil {
+		if isAWSErr(err, elasticache.ErrCodeCacheSecurityGroupNotFoundFault, "") {
+			log.Printf("[WARN] ElastiCache Security Group (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
 		return err
 	}
 	if len(res.CacheSecurityGroups) == 0 {
-		return fmt.Errorf("Error missing %v", d.Id())
+		log.Printf("[WARN] ElastiCache Security Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
 	}
 
 	var group *elasticache.CacheSecurityGroup
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/errwrap"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/elasticsearchservice/waiter"
 )
 
 func resourceAwsElasticSearchDomain() *schema.Resource {
@@ -25,6 +26,8 @@ func resourceAwsElasticSearchDomain() *schema.Resource {
 			State: resourceAwsElasticSearchDomainImport,
 		},
 
+		CustomizeDiff: resourceAwsElasticSearchDomainCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"access_policies": {
 				Type:             schema.TypeString,
@@ -140,7 +143,6 @@ func resourceAwsElasticSearchDomain() *schema.Resource {
 			"vpc_options": {
 				Type:     schema.TypeList,
 				Optional: true,
-				ForceNew: true,
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -603,28 +605,22 @@ func resourceAwsElasticSearchDomainUpdate(d *schema.ResourceData, meta interface
 		}
 	}
 
+	domainName := d.Get("domain_name").(string)
+
+	// Wait for any change still being processed, e.g. by an
+	// aws_elasticsearch_domain_policy, as the domain rejects updates in the
+	// meantime.
+	if _, err := waiter.DomainProcessed(conn, domainName, waiter.DomainProcessedTimeout); err != nil {
+		return fmt.Errorf("Error waiting for ElasticSearch domain (%s) to be processed: %s", domainName, err)
+	}
+
 	_, err := conn.UpdateElasticsearchDomainConfig(&input)
 	if err != nil {
 		return err
 	}
 
-	err = resource.Retry(60*time.Minute, func() *resource.RetryError {
-		out, err := conn.DescribeElasticsearchDomain(&elasticsearch.DescribeElasticsearchDomainInput{
-			DomainName: aws.String(d.Get("domain_name").(string)),
-		})
-		if err != nil {
-			return resource.NonRetryableError(err)
-		}
-
-		if *out.DomainStatus.Processing == false {
-			return nil
-		}
-
-		return resource.RetryableError(
-			fmt.Errorf("%q: Timeout while waiting for changes to be processed", d.Id()))
-	})
-	if err != nil {
-		return err
+	if _, err := waiter.DomainProcessed(conn, domainName, waiter.DomainProcessedTimeout); err != nil {
+		return fmt.Errorf("Error waiting for ElasticSearch domain (%s) to be updated: %s", domainName, err)
 	}
 
 	d.Partial(false)
@@ -632,6 +628,22 @@ func resourceAwsElasticSearchDomainUpdate(d *schema.ResourceData, meta interface
 	return resourceAwsElasticSearchDomainRead(d, meta)
 }
 
+// resourceAwsElasticSearchDomainCustomizeDiff forces a new domain when moving
+// it into or out of a VPC. The subnets and security groups of a VPC domain can
+// be changed in place.
+func resourceAwsElasticSearchDomainCustomizeDiff(diff *schema.ResourceDiff, v interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	o, n := diff.GetChange("vpc_options")
+	if len(o.([]interface{})) != len(n.([]interface{})) {
+		return diff.ForceNew("vpc_options")
+	}
+
+	return nil
+}
+
 func resourceAwsElasticSearchDomainDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).esconn
 
@@ -3,13 +3,13 @@ package aws
 import (
 	"fmt"
 	"log"
-	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	elasticsearch "github.com/aws/aws-sdk-go/service/elasticsearchservice"
-	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/elasticsearchservice/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
 )
 
 func resourceAwsElasticSearchDomainPolicy() *schema.Resource {
@@ -59,6 +59,13 @@ func resourceAwsElasticSearchDomainPolicyRead(d *schema.ResourceData, meta inter
 func resourceAwsElasticSearchDomainPolicyUpsert(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).esconn
 	domainName := d.Get("domain_name").(string)
+
+	// Wait for any change still being processed, e.g. by the domain itself,
+	// as the domain rejects updates in the meantime.
+	if _, err := waiter.DomainProcessed(conn, domainName, waiter.DomainProcessedTimeout); err != nil {
+		return fmt.Errorf("Error waiting for ElasticSearch domain (%s) to be processed: %s", domainName, err)
+	}
+
 	_, err := conn.UpdateElasticsearchDomainConfig(&elasticsearch.UpdateElasticsearchDomainConfigInput{
 		DomainName:     aws.String(domainName),
 		AccessPolicies: aws.String(d.Get("access_policies").(string)),
@@ -69,23 +76,8 @@ func resourceAwsElasticSearchDomainPolicyUpsert(d *schema.ResourceData, meta int
 
 	d.SetId("esd-policy-" + domainName)
 
-	err = resource.Retry(50*time.Minute, func() *resource.RetryError {
-		out, err := conn.DescribeElasticsearchDomain(&elasticsearch.DescribeElasticsearchDomainInput{
-			DomainName: aws.String(d.Get("domain_name").(string)),
-		})
-		if err != nil {
-			return resource.NonRetryableError(err)
-		}
-
-		if *out.DomainStatus.Processing == false {
-			return nil
-		}
-
-		return resource.RetryableError(
-			fmt.Errorf("%q: Timeout while waiting for changes to be processed", d.Id()))
-	})
-	if err != nil {
-		return err
+	if _, err := waiter.DomainProcessed(conn, domainName, waiter.DomainProcessedTimeout); err != nil {
+		return fmt.Errorf("Error waiting for ElasticSearch domain (%s) policy to be updated: %s", domainName, err)
 	}
 
 	return resourceAwsElasticSearchDomainPolicyRead(d, meta)
@@ -93,33 +85,26 @@ func resourceAwsElasticSearchDomainPolicyUpsert(d *schema.ResourceData, meta int
 
 func resourceAwsElasticSearchDomainPolicyDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).esconn
+	domainName := d.Get("domain_name").(string)
+
+	if _, err := waiter.DomainProcessed(conn, domainName, waiter.DomainProcessedTimeout); err != nil {
+		if tfresource.NotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("Error waiting for ElasticSearch domain (%s) to be processed: %s", domainName, err)
+	}
 
 	_, err := conn.UpdateElasticsearchDomainConfig(&elasticsearch.UpdateElasticsearchDomainConfigInput{
-		DomainName:     aws.String(d.Get("domain_name").(string)),
+		DomainName:     aws.String(domainName),
 		AccessPolicies: aws.String(""),
 	})
 	if err != nil {
 		return err
 	}
 
-	log.Printf("[DEBUG] Waiting for ElasticSearch domain policy %q to be deleted", d.Get("domain_name").(string))
-	err = resource.Retry(60*time.Minute, func() *resource.RetryError {
-		out, err := conn.DescribeElasticsearchDomain(&elasticsearch.DescribeElasticsearchDomainInput{
-			DomainName: aws.String(d.Get("domain_name").(string)),
-		})
-		if err != nil {
-			return resource.NonRetryableError(err)
-		}
-
-		if *out.DomainStatus.Processing == false {
-			return nil
-		}
-
-		return resource.RetryableError(
-			fmt.Errorf("%q: Timeout while waiting for policy to be deleted", d.Id()))
-	})
-	if err != nil {
-		return err
+	log.Printf("[DEBUG] Waiting for ElasticSearch domain policy %q to be deleted", domainName)
+	if _, err := waiter.DomainProcessed(conn, domainName, waiter.DomainProcessedTimeout); err != nil {
+		return fmt.Errorf("Error waiting for ElasticSearch domain (%s) policy to be deleted: %s", domainName, err)
 	}
 
 	d.SetId("")
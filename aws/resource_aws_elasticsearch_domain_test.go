@@ -165,7 +165,7 @@ func TestAccAWSElasticSearchDomain_vpc(t *testing.T) {
 }
 
 func TestAccAWSElasticSearchDomain_vpc_update(t *testing.T) {
-	var domain elasticsearch.ElasticsearchDomainStatus
+	var domain, updated elasticsearch.ElasticsearchDomainStatus
 	ri := acctest.RandInt()
 
 	resource.Test(t, resource.TestCase{
@@ -183,8 +183,9 @@ func TestAccAWSElasticSearchDomain_vpc_update(t *testing.T) {
 			{
 				Config: testAccESDomainConfig_vpc_update(ri, true),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckESDomainExists("aws_elasticsearch_domain.example", &domain),
-					testAccCheckESNumberOfSecurityGroups(2, &domain),
+					testAccCheckESDomainExists("aws_elasticsearch_domain.example", &updated),
+					testAccCheckESNumberOfSecurityGroups(2, &updated),
+					testAccCheckESDomainNotRecreated(&domain, &updated),
 				),
 			},
 		},
@@ -384,6 +385,15 @@ func testAccCheckESDomainExists(n string, domain *elasticsearch.ElasticsearchDom
 	}
 }
 
+func testAccCheckESDomainNotRecreated(before, after *elasticsearch.ElasticsearchDomainStatus) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.StringValue(before.DomainId) != aws.StringValue(after.DomainId) {
+			return fmt.Errorf("ElasticSearch Domain was recreated. Before %s. After %s", aws.StringValue(before.DomainId), aws.StringValue(after.DomainId))
+		}
+		return nil
+	}
+}
+
 func testAccCheckESDomainDestroy(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_elasticsearch_domain" {
@@ -58,10 +58,7 @@ func resourceAwsGlacierVault() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				ValidateFunc: validateJsonString,
-				StateFunc: func(v interface{}) string {
-					json, _ := normalizeJsonString(v)
-					return json
-				},
+				StateFunc:    normalizeJsonStateFunc,
 			},
 
 			"notification": &schema.Schema{
@@ -137,6 +134,11 @@ func resourceAwsGlacierVaultRead(d *schema.ResourceData, meta interface{}) error
 
 	out, err := glacierconn.DescribeVault(input)
 	if err != nil {
+		if isAWSErr(err, glacier.ErrCodeResourceNotFoundException, "") {
+			log.Printf("[WARN] Glacier Vault (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
 		return fmt.Errorf("Error reading Glacier Vault: %s", err.Error())
 	}
 
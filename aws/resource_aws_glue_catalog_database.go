@@ -0,0 +1,190 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsGlueCatalogDatabase() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsGlueCatalogDatabaseCreate,
+		Read:   resourceAwsGlueCatalogDatabaseRead,
+		Update: resourceAwsGlueCatalogDatabaseUpdate,
+		Delete: resourceAwsGlueCatalogDatabaseDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"catalog_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"location_uri": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"parameters": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceAwsGlueCatalogDatabaseCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+
+	catalogID := createAwsGlueCatalogID(d, meta.(*AWSClient).accountid)
+	name := d.Get("name").(string)
+
+	input := &glue.CreateDatabaseInput{
+		CatalogId:     aws.String(catalogID),
+		DatabaseInput: expandGlueDatabaseInput(d),
+	}
+
+	log.Printf("[DEBUG] Creating Glue Catalog Database: %s", input)
+	_, err := conn.CreateDatabase(input)
+	if err != nil {
+		return fmt.Errorf("Error creating Glue Catalog Database (%s): %s", name, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", catalogID, name))
+
+	return resourceAwsGlueCatalogDatabaseRead(d, meta)
+}
+
+func resourceAwsGlueCatalogDatabaseRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+
+	catalogID, name, err := readAwsGlueCatalogDatabaseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	out, err := conn.GetDatabase(&glue.GetDatabaseInput{
+		CatalogId: aws.String(catalogID),
+		Name:      aws.String(name),
+	})
+	if err != nil {
+		if isAWSErr(err, glue.ErrCodeEntityNotFoundException, "") {
+			log.Printf("[WARN] Glue Catalog Database (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading Glue Catalog Database (%s): %s", d.Id(), err)
+	}
+
+	database := out.Database
+
+	d.Set("catalog_id", catalogID)
+	d.Set("description", database.Description)
+	d.Set("location_uri", database.LocationUri)
+	d.Set("name", database.Name)
+	if err := d.Set("parameters", aws.StringValueMap(database.Parameters)); err != nil {
+		return fmt.Errorf("Error setting parameters: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsGlueCatalogDatabaseUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+
+	catalogID, name, err := readAwsGlueCatalogDatabaseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &glue.UpdateDatabaseInput{
+		CatalogId:     aws.String(catalogID),
+		DatabaseInput: expandGlueDatabaseInput(d),
+		Name:          aws.String(name),
+	}
+
+	log.Printf("[DEBUG] Updating Glue Catalog Database: %s", input)
+	_, err = conn.UpdateDatabase(input)
+	if err != nil {
+		return fmt.Errorf("Error updating Glue Catalog Database (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsGlueCatalogDatabaseRead(d, meta)
+}
+
+func resourceAwsGlueCatalogDatabaseDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+
+	catalogID, name, err := readAwsGlueCatalogDatabaseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Glue Catalog Database: %s", d.Id())
+	_, err = conn.DeleteDatabase(&glue.DeleteDatabaseInput{
+		CatalogId: aws.String(catalogID),
+		Name:      aws.String(name),
+	})
+	if err != nil {
+		if isAWSErr(err, glue.ErrCodeEntityNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Glue Catalog Database (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandGlueDatabaseInput(d *schema.ResourceData) *glue.DatabaseInput {
+	input := &glue.DatabaseInput{
+		Name: aws.String(d.Get("name").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("location_uri"); ok {
+		input.LocationUri = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("parameters"); ok {
+		input.Parameters = stringMapToPointers(v.(map[string]interface{}))
+	}
+
+	return input
+}
+
+// createAwsGlueCatalogID returns the configured catalog ID, which defaults to
+// the ID of the account.
+func createAwsGlueCatalogID(d *schema.ResourceData, accountid string) string {
+	if v, ok := d.GetOk("catalog_id"); ok {
+		return v.(string)
+	}
+	return accountid
+}
+
+func readAwsGlueCatalogDatabaseID(id string) (string, string, error) {
+	idParts := strings.Split(id, ":")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return "", "", fmt.Errorf("Unexpected format of ID (%q), expected CATALOG-ID:DATABASE-NAME", id)
+	}
+	return idParts[0], idParts[1], nil
+}
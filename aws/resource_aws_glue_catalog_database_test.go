@@ -0,0 +1,158 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestReadAwsGlueCatalogDatabaseID(t *testing.T) {
+	cases := []struct {
+		ID          string
+		CatalogID   string
+		Name        string
+		ErrExpected bool
+	}{
+		{ID: "123456789012:my_database", CatalogID: "123456789012", Name: "my_database"},
+		{ID: "my_database", ErrExpected: true},
+		{ID: "123456789012:", ErrExpected: true},
+		{ID: "123456789012:my_database:my_table", ErrExpected: true},
+	}
+
+	for _, tc := range cases {
+		catalogID, name, err := readAwsGlueCatalogDatabaseID(tc.ID)
+		if tc.ErrExpected {
+			if err == nil {
+				t.Errorf("%q: expected an error", tc.ID)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%q: unexpected error: %s", tc.ID, err)
+			continue
+		}
+		if catalogID != tc.CatalogID || name != tc.Name {
+			t.Errorf("%q: expected %q and %q, got %q and %q", tc.ID, tc.CatalogID, tc.Name, catalogID, name)
+		}
+	}
+}
+
+func TestAccAWSGlueCatalogDatabase_basic(t *testing.T) {
+	resourceName := "aws_glue_catalog_database.test"
+	rName := fmt.Sprintf("tf_acc_test_%s", acctest.RandString(8))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGlueCatalogDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlueCatalogDatabaseConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlueCatalogDatabaseExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttrSet(resourceName, "catalog_id"),
+				),
+			},
+			{
+				Config: testAccGlueCatalogDatabaseConfig_full(rName, "A test database"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlueCatalogDatabaseExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "A test database"),
+					resource.TestCheckResourceAttr(resourceName, "location_uri", "my-location"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.param1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckGlueCatalogDatabaseDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).glueconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_glue_catalog_database" {
+			continue
+		}
+
+		catalogID, name, err := readAwsGlueCatalogDatabaseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.GetDatabase(&glue.GetDatabaseInput{
+			CatalogId: aws.String(catalogID),
+			Name:      aws.String(name),
+		})
+		if err != nil {
+			if isAWSErr(err, glue.ErrCodeEntityNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		return fmt.Errorf("Glue Catalog Database %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckGlueCatalogDatabaseExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		catalogID, dbName, err := readAwsGlueCatalogDatabaseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).glueconn
+		_, err = conn.GetDatabase(&glue.GetDatabaseInput{
+			CatalogId: aws.String(catalogID),
+			Name:      aws.String(dbName),
+		})
+
+		return err
+	}
+}
+
+func testAccGlueCatalogDatabaseConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_glue_catalog_database" "test" {
+  name = "%s"
+}
+`, rName)
+}
+
+func testAccGlueCatalogDatabaseConfig_full(rName, desc string) string {
+	return fmt.Sprintf(`
+resource "aws_glue_catalog_database" "test" {
+  name         = "%s"
+  description  = "%s"
+  location_uri = "my-location"
+
+  parameters {
+    param1 = "value1"
+  }
+}
+`, rName, desc)
+}
@@ -0,0 +1,243 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestReadAwsGlueCatalogTableID(t *testing.T) {
+	cases := []struct {
+		ID          string
+		CatalogID   string
+		DBName      string
+		Name        string
+		ErrExpected bool
+	}{
+		{ID: "123456789012:my_database:my_table", CatalogID: "123456789012", DBName: "my_database", Name: "my_table"},
+		{ID: "123456789012:my_database", ErrExpected: true},
+		{ID: "123456789012::my_table", ErrExpected: true},
+	}
+
+	for _, tc := range cases {
+		catalogID, dbName, name, err := readAwsGlueCatalogTableID(tc.ID)
+		if tc.ErrExpected {
+			if err == nil {
+				t.Errorf("%q: expected an error", tc.ID)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%q: unexpected error: %s", tc.ID, err)
+			continue
+		}
+		if catalogID != tc.CatalogID || dbName != tc.DBName || name != tc.Name {
+			t.Errorf("%q: expected %q, %q and %q, got %q, %q and %q", tc.ID, tc.CatalogID, tc.DBName, tc.Name, catalogID, dbName, name)
+		}
+	}
+}
+
+func TestAccAWSGlueCatalogTable_basic(t *testing.T) {
+	resourceName := "aws_glue_catalog_table.test"
+	rName := fmt.Sprintf("tf_acc_test_%s", acctest.RandString(8))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGlueCatalogTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlueCatalogTableConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlueCatalogTableExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "database_name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "catalog_id"),
+				),
+			},
+			{
+				Config: testAccGlueCatalogTableConfig_full(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlueCatalogTableExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "A test table"),
+					resource.TestCheckResourceAttr(resourceName, "owner", "my_owner"),
+					resource.TestCheckResourceAttr(resourceName, "retention", "1"),
+					resource.TestCheckResourceAttr(resourceName, "table_type", "EXTERNAL_TABLE"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "partition_keys.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "partition_keys.0.name", "my_partition"),
+					resource.TestCheckResourceAttr(resourceName, "partition_keys.0.type", "int"),
+					resource.TestCheckResourceAttr(resourceName, "storage_descriptor.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_descriptor.0.columns.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "storage_descriptor.0.columns.0.name", "my_column_1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_descriptor.0.compressed", "false"),
+					resource.TestCheckResourceAttr(resourceName, "storage_descriptor.0.location", "my_location"),
+					resource.TestCheckResourceAttr(resourceName, "storage_descriptor.0.number_of_buckets", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_descriptor.0.ser_de_info.0.serialization_library", "org.apache.hadoop.hive.serde2.columnar.ColumnarSerDe"),
+					resource.TestCheckResourceAttr(resourceName, "storage_descriptor.0.sort_columns.0.column", "my_column_1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_descriptor.0.sort_columns.0.sort_order", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_descriptor.0.skewed_info.0.skewed_column_names.0", "my_column_1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckGlueCatalogTableDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).glueconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_glue_catalog_table" {
+			continue
+		}
+
+		catalogID, dbName, name, err := readAwsGlueCatalogTableID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.GetTable(&glue.GetTableInput{
+			CatalogId:    aws.String(catalogID),
+			DatabaseName: aws.String(dbName),
+			Name:         aws.String(name),
+		})
+		if err != nil {
+			if isAWSErr(err, glue.ErrCodeEntityNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		return fmt.Errorf("Glue Catalog Table %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckGlueCatalogTableExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		catalogID, dbName, tableName, err := readAwsGlueCatalogTableID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).glueconn
+		_, err = conn.GetTable(&glue.GetTableInput{
+			CatalogId:    aws.String(catalogID),
+			DatabaseName: aws.String(dbName),
+			Name:         aws.String(tableName),
+		})
+
+		return err
+	}
+}
+
+func testAccGlueCatalogTableConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_glue_catalog_database" "test" {
+  name = "%[1]s"
+}
+
+resource "aws_glue_catalog_table" "test" {
+  name          = "%[1]s"
+  database_name = "${aws_glue_catalog_database.test.name}"
+}
+`, rName)
+}
+
+func testAccGlueCatalogTableConfig_full(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_glue_catalog_database" "test" {
+  name = "%[1]s"
+}
+
+resource "aws_glue_catalog_table" "test" {
+  name          = "%[1]s"
+  database_name = "${aws_glue_catalog_database.test.name}"
+  description   = "A test table"
+  owner         = "my_owner"
+  retention     = 1
+  table_type    = "EXTERNAL_TABLE"
+
+  parameters {
+    param1 = "value1"
+  }
+
+  partition_keys = [
+    {
+      name    = "my_partition"
+      type    = "int"
+      comment = "my_partition_comment"
+    },
+  ]
+
+  storage_descriptor {
+    location          = "my_location"
+    input_format      = "SequenceFileInputFormat"
+    output_format     = "SequenceFileInputFormat"
+    compressed        = false
+    number_of_buckets = 1
+    bucket_columns    = ["bucket_column_1"]
+
+    columns = [
+      {
+        name    = "my_column_1"
+        type    = "int"
+        comment = "my_column1_comment"
+      },
+      {
+        name    = "my_column_2"
+        type    = "string"
+        comment = "my_column2_comment"
+      },
+    ]
+
+    ser_de_info {
+      name                  = "ser_de_name"
+      serialization_library = "org.apache.hadoop.hive.serde2.columnar.ColumnarSerDe"
+
+      parameters {
+        param1 = "param_val_1"
+      }
+    }
+
+    sort_columns = [
+      {
+        column     = "my_column_1"
+        sort_order = 1
+      },
+    ]
+
+    skewed_info {
+      skewed_column_names  = ["my_column_1"]
+      skewed_column_values = ["skewed_val_1"]
+
+      skewed_column_value_location_maps {
+        my_column_1 = "my_column_1_val_loc_map"
+      }
+    }
+
+    stored_as_sub_directories = false
+  }
+}
+`, rName)
+}
@@ -0,0 +1,161 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSGlueClassifier_grokClassifier(t *testing.T) {
+	var classifier glue.Classifier
+	resourceName := "aws_glue_classifier.test"
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(8))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGlueClassifierDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlueClassifierConfig_grokClassifier(rName, "classification1", "pattern1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlueClassifierExists(resourceName, &classifier),
+					resource.TestCheckResourceAttr(resourceName, "grok_classifier.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "grok_classifier.0.classification", "classification1"),
+					resource.TestCheckResourceAttr(resourceName, "grok_classifier.0.custom_patterns", ""),
+					resource.TestCheckResourceAttr(resourceName, "grok_classifier.0.grok_pattern", "pattern1"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "xml_classifier.#", "0"),
+				),
+			},
+			{
+				Config: testAccGlueClassifierConfig_grokClassifier(rName, "classification2", "pattern2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlueClassifierExists(resourceName, &classifier),
+					resource.TestCheckResourceAttr(resourceName, "grok_classifier.0.classification", "classification2"),
+					resource.TestCheckResourceAttr(resourceName, "grok_classifier.0.grok_pattern", "pattern2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSGlueClassifier_typeChange(t *testing.T) {
+	var classifier glue.Classifier
+	resourceName := "aws_glue_classifier.test"
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(8))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGlueClassifierDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlueClassifierConfig_grokClassifier(rName, "classification1", "pattern1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlueClassifierExists(resourceName, &classifier),
+					resource.TestCheckResourceAttr(resourceName, "grok_classifier.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "xml_classifier.#", "0"),
+				),
+			},
+			{
+				Config: testAccGlueClassifierConfig_xmlClassifier(rName, "classification1", "rowtag1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlueClassifierExists(resourceName, &classifier),
+					resource.TestCheckResourceAttr(resourceName, "grok_classifier.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "xml_classifier.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "xml_classifier.0.classification", "classification1"),
+					resource.TestCheckResourceAttr(resourceName, "xml_classifier.0.row_tag", "rowtag1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGlueClassifierExists(resourceName string, classifier *glue.Classifier) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Glue Classifier ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).glueconn
+		output, err := conn.GetClassifier(&glue.GetClassifierInput{
+			Name: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		if output.Classifier == nil {
+			return fmt.Errorf("Glue Classifier (%s) not found", rs.Primary.ID)
+		}
+
+		*classifier = *output.Classifier
+		return nil
+	}
+}
+
+func testAccCheckGlueClassifierDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).glueconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_glue_classifier" {
+			continue
+		}
+
+		_, err := conn.GetClassifier(&glue.GetClassifierInput{
+			Name: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			if isAWSErr(err, glue.ErrCodeEntityNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		return fmt.Errorf("Glue Classifier %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccGlueClassifierConfig_grokClassifier(rName, classification, grokPattern string) string {
+	return fmt.Sprintf(`
+resource "aws_glue_classifier" "test" {
+  name = "%s"
+
+  grok_classifier {
+    classification = "%s"
+    grok_pattern   = "%s"
+  }
+}
+`, rName, classification, grokPattern)
+}
+
+func testAccGlueClassifierConfig_xmlClassifier(rName, classification, rowTag string) string {
+	return fmt.Sprintf(`
+resource "aws_glue_classifier" "test" {
+  name = "%s"
+
+  xml_classifier {
+    classification = "%s"
+    row_tag        = "%s"
+  }
+}
+`, rName, classification, rowTag)
+}
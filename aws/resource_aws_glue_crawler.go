@@ -0,0 +1,339 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsGlueCrawler() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsGlueCrawlerCreate,
+		Read:   resourceAwsGlueCrawlerRead,
+		Update: resourceAwsGlueCrawlerUpdate,
+		Delete: resourceAwsGlueCrawlerDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"classifiers": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"configuration": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validateJsonString,
+				DiffSuppressFunc: suppressEquivalentJsonDiffs,
+			},
+			"database_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 2048),
+			},
+			"jdbc_target": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"connection_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"exclusions": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"path": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"role": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"s3_target": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"exclusions": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"path": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"schedule": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"schema_change_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"delete_behavior": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  glue.DeleteBehaviorDeprecateInDatabase,
+							ValidateFunc: validation.StringInSlice([]string{
+								glue.DeleteBehaviorDeleteFromDatabase,
+								glue.DeleteBehaviorDeprecateInDatabase,
+								glue.DeleteBehaviorLog,
+							}, false),
+						},
+						"update_behavior": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  glue.UpdateBehaviorUpdateInDatabase,
+							ValidateFunc: validation.StringInSlice([]string{
+								glue.UpdateBehaviorLog,
+								glue.UpdateBehaviorUpdateInDatabase,
+							}, false),
+						},
+					},
+				},
+			},
+			"table_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceAwsGlueCrawlerCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+	name := d.Get("name").(string)
+
+	input := &glue.CreateCrawlerInput{
+		Classifiers:        expandStringList(d.Get("classifiers").([]interface{})),
+		DatabaseName:       aws.String(d.Get("database_name").(string)),
+		Name:               aws.String(name),
+		Role:               aws.String(d.Get("role").(string)),
+		SchemaChangePolicy: expandGlueSchemaChangePolicy(d.Get("schema_change_policy").([]interface{})),
+		Targets:            expandGlueCrawlerTargets(d),
+	}
+	if v, ok := d.GetOk("configuration"); ok {
+		input.Configuration = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("schedule"); ok {
+		input.Schedule = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("table_prefix"); ok {
+		input.TablePrefix = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Glue Crawler: %s", input)
+	_, err := conn.CreateCrawler(input)
+	if err != nil {
+		return fmt.Errorf("Error creating Glue Crawler (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsGlueCrawlerRead(d, meta)
+}
+
+func resourceAwsGlueCrawlerRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+
+	out, err := conn.GetCrawler(&glue.GetCrawlerInput{
+		Name: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, glue.ErrCodeEntityNotFoundException, "") {
+			log.Printf("[WARN] Glue Crawler (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading Glue Crawler (%s): %s", d.Id(), err)
+	}
+
+	crawler := out.Crawler
+
+	d.Set("configuration", crawler.Configuration)
+	d.Set("database_name", crawler.DatabaseName)
+	d.Set("description", crawler.Description)
+	d.Set("name", crawler.Name)
+	d.Set("role", crawler.Role)
+	d.Set("table_prefix", crawler.TablePrefix)
+
+	if crawler.Schedule != nil {
+		d.Set("schedule", crawler.Schedule.ScheduleExpression)
+	} else {
+		d.Set("schedule", "")
+	}
+
+	if err := d.Set("classifiers", flattenStringList(crawler.Classifiers)); err != nil {
+		return fmt.Errorf("Error setting classifiers: %s", err)
+	}
+	if err := d.Set("schema_change_policy", flattenGlueSchemaChangePolicy(crawler.SchemaChangePolicy)); err != nil {
+		return fmt.Errorf("Error setting schema_change_policy: %s", err)
+	}
+
+	var s3Targets []*glue.S3Target
+	var jdbcTargets []*glue.JdbcTarget
+	if crawler.Targets != nil {
+		s3Targets = crawler.Targets.S3Targets
+		jdbcTargets = crawler.Targets.JdbcTargets
+	}
+	if err := d.Set("s3_target", flattenGlueS3Targets(s3Targets)); err != nil {
+		return fmt.Errorf("Error setting s3_target: %s", err)
+	}
+	if err := d.Set("jdbc_target", flattenGlueJdbcTargets(jdbcTargets)); err != nil {
+		return fmt.Errorf("Error setting jdbc_target: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsGlueCrawlerUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+
+	input := &glue.UpdateCrawlerInput{
+		Classifiers:        expandStringList(d.Get("classifiers").([]interface{})),
+		Configuration:      aws.String(d.Get("configuration").(string)),
+		DatabaseName:       aws.String(d.Get("database_name").(string)),
+		Description:        aws.String(d.Get("description").(string)),
+		Name:               aws.String(d.Id()),
+		Role:               aws.String(d.Get("role").(string)),
+		Schedule:           aws.String(d.Get("schedule").(string)),
+		SchemaChangePolicy: expandGlueSchemaChangePolicy(d.Get("schema_change_policy").([]interface{})),
+		TablePrefix:        aws.String(d.Get("table_prefix").(string)),
+		Targets:            expandGlueCrawlerTargets(d),
+	}
+
+	log.Printf("[DEBUG] Updating Glue Crawler: %s", input)
+	_, err := conn.UpdateCrawler(input)
+	if err != nil {
+		return fmt.Errorf("Error updating Glue Crawler (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsGlueCrawlerRead(d, meta)
+}
+
+func resourceAwsGlueCrawlerDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+
+	log.Printf("[DEBUG] Deleting Glue Crawler: %s", d.Id())
+	_, err := conn.DeleteCrawler(&glue.DeleteCrawlerInput{
+		Name: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, glue.ErrCodeEntityNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Glue Crawler (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandGlueCrawlerTargets(d *schema.ResourceData) *glue.CrawlerTargets {
+	targets := &glue.CrawlerTargets{
+		JdbcTargets: []*glue.JdbcTarget{},
+		S3Targets:   []*glue.S3Target{},
+	}
+
+	for _, v := range d.Get("s3_target").([]interface{}) {
+		m := v.(map[string]interface{})
+		targets.S3Targets = append(targets.S3Targets, &glue.S3Target{
+			Exclusions: expandStringList(m["exclusions"].([]interface{})),
+			Path:       aws.String(m["path"].(string)),
+		})
+	}
+
+	for _, v := range d.Get("jdbc_target").([]interface{}) {
+		m := v.(map[string]interface{})
+		targets.JdbcTargets = append(targets.JdbcTargets, &glue.JdbcTarget{
+			ConnectionName: aws.String(m["connection_name"].(string)),
+			Exclusions:     expandStringList(m["exclusions"].([]interface{})),
+			Path:           aws.String(m["path"].(string)),
+		})
+	}
+
+	return targets
+}
+
+func expandGlueSchemaChangePolicy(l []interface{}) *glue.SchemaChangePolicy {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	return &glue.SchemaChangePolicy{
+		DeleteBehavior: aws.String(m["delete_behavior"].(string)),
+		UpdateBehavior: aws.String(m["update_behavior"].(string)),
+	}
+}
+
+func flattenGlueSchemaChangePolicy(p *glue.SchemaChangePolicy) []map[string]interface{} {
+	if p == nil {
+		return []map[string]interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"delete_behavior": aws.StringValue(p.DeleteBehavior),
+		"update_behavior": aws.StringValue(p.UpdateBehavior),
+	}
+
+	return []map[string]interface{}{m}
+}
+
+func flattenGlueS3Targets(targets []*glue.S3Target) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(targets))
+	for _, t := range targets {
+		result = append(result, map[string]interface{}{
+			"exclusions": flattenStringList(t.Exclusions),
+			"path":       aws.StringValue(t.Path),
+		})
+	}
+
+	return result
+}
+
+func flattenGlueJdbcTargets(targets []*glue.JdbcTarget) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(targets))
+	for _, t := range targets {
+		result = append(result, map[string]interface{}{
+			"connection_name": aws.StringValue(t.ConnectionName),
+			"exclusions":      flattenStringList(t.Exclusions),
+			"path":            aws.StringValue(t.Path),
+		})
+	}
+
+	return result
+}
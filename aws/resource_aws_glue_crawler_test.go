@@ -0,0 +1,179 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSGlueCrawler_s3Target(t *testing.T) {
+	var crawler glue.Crawler
+	resourceName := "aws_glue_crawler.test"
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(8))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGlueCrawlerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlueCrawlerConfig_s3Target(rName, "s3://bucket1", "cron(15 12 * * ? *)"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlueCrawlerExists(resourceName, &crawler),
+					resource.TestCheckResourceAttr(resourceName, "database_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "jdbc_target.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "role", "aws_iam_role.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "s3_target.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "s3_target.0.path", "s3://bucket1"),
+					resource.TestCheckResourceAttr(resourceName, "schedule", "cron(15 12 * * ? *)"),
+					resource.TestCheckResourceAttr(resourceName, "schema_change_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "schema_change_policy.0.delete_behavior", "DEPRECATE_IN_DATABASE"),
+					resource.TestCheckResourceAttr(resourceName, "schema_change_policy.0.update_behavior", "UPDATE_IN_DATABASE"),
+				),
+			},
+			{
+				Config: testAccGlueCrawlerConfig_s3Target(rName, "s3://bucket2", "cron(45 12 * * ? *)"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlueCrawlerExists(resourceName, &crawler),
+					resource.TestCheckResourceAttr(resourceName, "s3_target.0.path", "s3://bucket2"),
+					resource.TestCheckResourceAttr(resourceName, "schedule", "cron(45 12 * * ? *)"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSGlueCrawler_jdbcTarget(t *testing.T) {
+	var crawler glue.Crawler
+	resourceName := "aws_glue_crawler.test"
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(8))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGlueCrawlerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlueCrawlerConfig_jdbcTarget(rName, "database-name/%"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlueCrawlerExists(resourceName, &crawler),
+					resource.TestCheckResourceAttr(resourceName, "jdbc_target.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "jdbc_target.0.connection_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "jdbc_target.0.exclusions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "jdbc_target.0.path", "database-name/%"),
+					resource.TestCheckResourceAttr(resourceName, "s3_target.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGlueCrawlerExists(resourceName string, crawler *glue.Crawler) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Glue Crawler ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).glueconn
+		output, err := conn.GetCrawler(&glue.GetCrawlerInput{
+			Name: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		if output.Crawler == nil {
+			return fmt.Errorf("Glue Crawler (%s) not found", rs.Primary.ID)
+		}
+
+		*crawler = *output.Crawler
+		return nil
+	}
+}
+
+func testAccCheckGlueCrawlerDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).glueconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_glue_crawler" {
+			continue
+		}
+
+		_, err := conn.GetCrawler(&glue.GetCrawlerInput{
+			Name: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			if isAWSErr(err, glue.ErrCodeEntityNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		return fmt.Errorf("Glue Crawler %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccGlueCrawlerConfig_s3Target(rName, path, schedule string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "aws_glue_catalog_database" "test" {
+  name = "%s"
+}
+
+resource "aws_glue_crawler" "test" {
+  database_name = "${aws_glue_catalog_database.test.name}"
+  name          = "%s"
+  role          = "${aws_iam_role.test.name}"
+  schedule      = "%s"
+
+  s3_target {
+    path = "%s"
+  }
+
+  depends_on = ["aws_iam_role_policy_attachment.test"]
+}
+`, testAccGlueJobConfig_base(rName), rName, rName, schedule, path)
+}
+
+func testAccGlueCrawlerConfig_jdbcTarget(rName, path string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "aws_glue_catalog_database" "test" {
+  name = "%s"
+}
+
+resource "aws_glue_crawler" "test" {
+  database_name = "${aws_glue_catalog_database.test.name}"
+  name          = "%s"
+  role          = "${aws_iam_role.test.name}"
+
+  jdbc_target {
+    connection_name = "%s"
+    path            = "%s"
+    exclusions      = ["exclusion1"]
+  }
+
+  depends_on = ["aws_iam_role_policy_attachment.test"]
+}
+`, testAccGlueJobConfig_base(rName), rName, rName, rName, path)
+}
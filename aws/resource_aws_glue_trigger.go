@@ -0,0 +1,420 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsGlueTrigger() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsGlueTriggerCreate,
+		Read:   resourceAwsGlueTriggerRead,
+		Update: resourceAwsGlueTriggerUpdate,
+		Delete: resourceAwsGlueTriggerDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"actions": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arguments": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"job_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"predicate": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"conditions": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"job_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"logical_operator": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  glue.LogicalOperatorEquals,
+										ValidateFunc: validation.StringInSlice([]string{
+											glue.LogicalOperatorEquals,
+										}, false),
+									},
+									"state": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											glue.JobRunStateFailed,
+											glue.JobRunStateRunning,
+											glue.JobRunStateStarting,
+											glue.JobRunStateStopped,
+											glue.JobRunStateStopping,
+											glue.JobRunStateSucceeded,
+										}, false),
+									},
+								},
+							},
+						},
+						"logical": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  glue.LogicalAnd,
+							ValidateFunc: validation.StringInSlice([]string{
+								glue.LogicalAnd,
+							}, false),
+						},
+					},
+				},
+			},
+			"schedule": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					glue.TriggerTypeConditional,
+					glue.TriggerTypeOnDemand,
+					glue.TriggerTypeScheduled,
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceAwsGlueTriggerCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+	name := d.Get("name").(string)
+
+	input := &glue.CreateTriggerInput{
+		Actions: expandGlueActions(d.Get("actions").([]interface{})),
+		Name:    aws.String(name),
+		Type:    aws.String(d.Get("type").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("predicate"); ok {
+		input.Predicate = expandGluePredicate(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("schedule"); ok {
+		input.Schedule = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Glue Trigger: %s", input)
+	_, err := conn.CreateTrigger(input)
+	if err != nil {
+		return fmt.Errorf("Error creating Glue Trigger (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if d.Get("enabled").(bool) && d.Get("type").(string) != glue.TriggerTypeOnDemand {
+		if err := startGlueTrigger(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsGlueTriggerRead(d, meta)
+}
+
+func resourceAwsGlueTriggerRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+
+	trigger, err := readGlueTrigger(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("Error reading Glue Trigger (%s): %s", d.Id(), err)
+	}
+	if trigger == nil {
+		log.Printf("[WARN] Glue Trigger (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("description", trigger.Description)
+	d.Set("name", trigger.Name)
+	d.Set("schedule", trigger.Schedule)
+	d.Set("type", trigger.Type)
+
+	// On demand triggers are never activated, so their state says nothing
+	// about whether they are enabled.
+	if aws.StringValue(trigger.Type) != glue.TriggerTypeOnDemand {
+		d.Set("enabled", aws.StringValue(trigger.State) == glue.TriggerStateActivated)
+	}
+
+	if err := d.Set("actions", flattenGlueActions(trigger.Actions)); err != nil {
+		return fmt.Errorf("Error setting actions: %s", err)
+	}
+	if err := d.Set("predicate", flattenGluePredicate(trigger.Predicate)); err != nil {
+		return fmt.Errorf("Error setting predicate: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsGlueTriggerUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+
+	if d.HasChange("actions") || d.HasChange("description") || d.HasChange("predicate") || d.HasChange("schedule") {
+		triggerUpdate := &glue.TriggerUpdate{
+			Actions:     expandGlueActions(d.Get("actions").([]interface{})),
+			Description: aws.String(d.Get("description").(string)),
+		}
+		if v, ok := d.GetOk("predicate"); ok {
+			triggerUpdate.Predicate = expandGluePredicate(v.([]interface{}))
+		}
+		if v, ok := d.GetOk("schedule"); ok {
+			triggerUpdate.Schedule = aws.String(v.(string))
+		}
+
+		input := &glue.UpdateTriggerInput{
+			Name:          aws.String(d.Id()),
+			TriggerUpdate: triggerUpdate,
+		}
+
+		log.Printf("[DEBUG] Updating Glue Trigger: %s", input)
+		_, err := conn.UpdateTrigger(input)
+		if err != nil {
+			return fmt.Errorf("Error updating Glue Trigger (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("enabled") && d.Get("type").(string) != glue.TriggerTypeOnDemand {
+		var err error
+		if d.Get("enabled").(bool) {
+			err = startGlueTrigger(conn, d.Id(), d.Timeout(schema.TimeoutUpdate))
+		} else {
+			err = stopGlueTrigger(conn, d.Id(), d.Timeout(schema.TimeoutUpdate))
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsGlueTriggerRead(d, meta)
+}
+
+func resourceAwsGlueTriggerDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+
+	log.Printf("[DEBUG] Deleting Glue Trigger: %s", d.Id())
+	_, err := conn.DeleteTrigger(&glue.DeleteTriggerInput{
+		Name: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, glue.ErrCodeEntityNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Glue Trigger (%s): %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{glue.TriggerStateDeleting},
+		Target:     []string{""},
+		Refresh:    glueTriggerStateRefreshFunc(conn, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		MinTimeout: 3 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for Glue Trigger (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func readGlueTrigger(conn *glue.Glue, name string) (*glue.Trigger, error) {
+	out, err := conn.GetTrigger(&glue.GetTriggerInput{
+		Name: aws.String(name),
+	})
+	if err != nil {
+		if isAWSErr(err, glue.ErrCodeEntityNotFoundException, "") {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return out.Trigger, nil
+}
+
+// glueTriggerStateRefreshFunc returns an empty state once the trigger no
+// longer exists.
+func glueTriggerStateRefreshFunc(conn *glue.Glue, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		trigger, err := readGlueTrigger(conn, name)
+		if err != nil {
+			return nil, "", err
+		}
+		if trigger == nil {
+			return "", "", nil
+		}
+
+		return trigger, aws.StringValue(trigger.State), nil
+	}
+}
+
+func startGlueTrigger(conn *glue.Glue, name string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Starting Glue Trigger: %s", name)
+	_, err := conn.StartTrigger(&glue.StartTriggerInput{
+		Name: aws.String(name),
+	})
+	if err != nil {
+		return fmt.Errorf("Error starting Glue Trigger (%s): %s", name, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{glue.TriggerStateActivating, glue.TriggerStateCreated, glue.TriggerStateCreating, glue.TriggerStateUpdating},
+		Target:     []string{glue.TriggerStateActivated},
+		Refresh:    glueTriggerStateRefreshFunc(conn, name),
+		Timeout:    timeout,
+		MinTimeout: 3 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for Glue Trigger (%s) to be activated: %s", name, err)
+	}
+
+	return nil
+}
+
+func stopGlueTrigger(conn *glue.Glue, name string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Stopping Glue Trigger: %s", name)
+	_, err := conn.StopTrigger(&glue.StopTriggerInput{
+		Name: aws.String(name),
+	})
+	if err != nil {
+		return fmt.Errorf("Error stopping Glue Trigger (%s): %s", name, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{glue.TriggerStateActivated, glue.TriggerStateDeactivating, glue.TriggerStateUpdating},
+		Target:     []string{glue.TriggerStateDeactivated},
+		Refresh:    glueTriggerStateRefreshFunc(conn, name),
+		Timeout:    timeout,
+		MinTimeout: 3 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for Glue Trigger (%s) to be deactivated: %s", name, err)
+	}
+
+	return nil
+}
+
+func expandGlueActions(l []interface{}) []*glue.Action {
+	actions := []*glue.Action{}
+	for _, v := range l {
+		m := v.(map[string]interface{})
+		action := &glue.Action{
+			JobName: aws.String(m["job_name"].(string)),
+		}
+		if v, ok := m["arguments"]; ok && len(v.(map[string]interface{})) > 0 {
+			action.Arguments = stringMapToPointers(v.(map[string]interface{}))
+		}
+		actions = append(actions, action)
+	}
+
+	return actions
+}
+
+func expandGluePredicate(l []interface{}) *glue.Predicate {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	predicate := &glue.Predicate{
+		Conditions: []*glue.Condition{},
+		Logical:    aws.String(m["logical"].(string)),
+	}
+	for _, v := range m["conditions"].([]interface{}) {
+		c := v.(map[string]interface{})
+		predicate.Conditions = append(predicate.Conditions, &glue.Condition{
+			JobName:         aws.String(c["job_name"].(string)),
+			LogicalOperator: aws.String(c["logical_operator"].(string)),
+			State:           aws.String(c["state"].(string)),
+		})
+	}
+
+	return predicate
+}
+
+func flattenGlueActions(actions []*glue.Action) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(actions))
+	for _, a := range actions {
+		result = append(result, map[string]interface{}{
+			"arguments": aws.StringValueMap(a.Arguments),
+			"job_name":  aws.StringValue(a.JobName),
+		})
+	}
+
+	return result
+}
+
+func flattenGluePredicate(p *glue.Predicate) []map[string]interface{} {
+	if p == nil {
+		return []map[string]interface{}{}
+	}
+
+	conditions := make([]map[string]interface{}, 0, len(p.Conditions))
+	for _, c := range p.Conditions {
+		conditions = append(conditions, map[string]interface{}{
+			"job_name":         aws.StringValue(c.JobName),
+			"logical_operator": aws.StringValue(c.LogicalOperator),
+			"state":            aws.StringValue(c.State),
+		})
+	}
+
+	m := map[string]interface{}{
+		"conditions": conditions,
+		"logical":    aws.StringValue(p.Logical),
+	}
+
+	return []map[string]interface{}{m}
+}
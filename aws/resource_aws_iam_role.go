@@ -1,7 +1,9 @@
 package aws
 
 import (
+	"bytes"
 	"fmt"
+	"log"
 	"net/url"
 	"regexp"
 	"time"
@@ -10,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/iam"
 
+	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 )
@@ -105,10 +108,51 @@ func resourceAwsIamRole() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+
+			"inline_policy": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Set:      resourceAwsIamRoleInlinePolicyHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"policy": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateIAMPolicyJson,
+							StateFunc:    normalizeJsonStateFunc,
+						},
+					},
+				},
+			},
+
+			"managed_policy_arns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateArn,
+				},
+				Set: schema.HashString,
+			},
 		},
 	}
 }
 
+func resourceAwsIamRoleInlinePolicyHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+	buf.WriteString(fmt.Sprintf("%s-", m["name"].(string)))
+	// Hash the normalized document so formatting differences between the
+	// configuration and the API response don't show up as a diff.
+	policy, _ := normalizeJsonString(m["policy"])
+	buf.WriteString(fmt.Sprintf("%s-", policy))
+	return hashcode.String(buf.String())
+}
+
 func resourceAwsIamRoleImport(
 	d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	d.Set("force_detach_policies", false)
@@ -152,6 +196,21 @@ func resourceAwsIamRoleCreate(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error creating IAM Role %s: %s", name, err)
 	}
 	d.SetId(*createResp.Role.RoleName)
+
+	if v, ok := d.GetOk("inline_policy"); ok {
+		if err := resourceAwsIamRolePutInlinePolicies(iamconn, d.Id(), v.(*schema.Set).List()); err != nil {
+			return err
+		}
+	}
+
+	if v, ok := d.GetOk("managed_policy_arns"); ok {
+		for _, arn := range v.(*schema.Set).List() {
+			if err := attachPolicyToRole(iamconn, d.Id(), arn.(string)); err != nil {
+				return fmt.Errorf("Error attaching policy %s to IAM Role %s: %s", arn, d.Id(), err)
+			}
+		}
+	}
+
 	return resourceAwsIamRoleRead(d, meta)
 }
 
@@ -203,6 +262,30 @@ func resourceAwsIamRoleRead(d *schema.ResourceData, meta interface{}) error {
 	if err := d.Set("assume_role_policy", assumRolePolicy); err != nil {
 		return err
 	}
+
+	// The role's policies are only tracked once they are configured, so that
+	// policies managed by aws_iam_role_policy and
+	// aws_iam_role_policy_attachment are left alone otherwise.
+	if _, ok := d.GetOk("inline_policy"); ok {
+		inlinePolicies, err := readIamRoleInlinePolicies(iamconn, d.Id())
+		if err != nil {
+			return fmt.Errorf("Error reading inline policies of IAM Role %s: %s", d.Id(), err)
+		}
+		if err := d.Set("inline_policy", inlinePolicies); err != nil {
+			return fmt.Errorf("Error setting inline_policy: %s", err)
+		}
+	}
+
+	if _, ok := d.GetOk("managed_policy_arns"); ok {
+		managedPolicyArns, err := readIamRoleManagedPolicyArns(iamconn, d.Id())
+		if err != nil {
+			return fmt.Errorf("Error reading attached policies of IAM Role %s: %s", d.Id(), err)
+		}
+		if err := d.Set("managed_policy_arns", managedPolicyArns); err != nil {
+			return fmt.Errorf("Error setting managed_policy_arns: %s", err)
+		}
+	}
+
 	return nil
 }
 
@@ -239,6 +322,49 @@ func resourceAwsIamRoleUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if d.HasChange("inline_policy") {
+		o, n := d.GetChange("inline_policy")
+		os := o.(*schema.Set)
+		ns := n.(*schema.Set)
+
+		// Policies are keyed by name, so only delete policies whose name is
+		// no longer configured; changed documents are overwritten below.
+		names := make(map[string]bool)
+		for _, p := range ns.List() {
+			names[p.(map[string]interface{})["name"].(string)] = true
+		}
+		for _, p := range os.Difference(ns).List() {
+			name := p.(map[string]interface{})["name"].(string)
+			if names[name] {
+				continue
+			}
+			if err := deleteIamRoleInlinePolicy(iamconn, d.Id(), name); err != nil {
+				return err
+			}
+		}
+
+		if err := resourceAwsIamRolePutInlinePolicies(iamconn, d.Id(), ns.Difference(os).List()); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("managed_policy_arns") {
+		o, n := d.GetChange("managed_policy_arns")
+		os := o.(*schema.Set)
+		ns := n.(*schema.Set)
+
+		for _, arn := range os.Difference(ns).List() {
+			if err := detachPolicyFromRole(iamconn, d.Id(), arn.(string)); err != nil && !isAWSErr(err, iam.ErrCodeNoSuchEntityException, "") {
+				return fmt.Errorf("Error detaching policy %s from IAM Role %s: %s", arn, d.Id(), err)
+			}
+		}
+		for _, arn := range ns.Difference(os).List() {
+			if err := attachPolicyToRole(iamconn, d.Id(), arn.(string)); err != nil {
+				return fmt.Errorf("Error attaching policy %s to IAM Role %s: %s", arn, d.Id(), err)
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -285,6 +411,28 @@ func resourceAwsIamRoleDelete(d *schema.ResourceData, meta interface{}) error {
 				}
 			}
 		}
+	} else if v, ok := d.GetOk("managed_policy_arns"); ok {
+		for _, arn := range v.(*schema.Set).List() {
+			if err := detachPolicyFromRole(iamconn, d.Id(), arn.(string)); err != nil && !isAWSErr(err, iam.ErrCodeNoSuchEntityException, "") {
+				return fmt.Errorf("Error deleting IAM Role %s: %s", d.Id(), err)
+			}
+		}
+	}
+
+	// Inline policies belong to the role and have to be deleted before it.
+	// Without force_detach_policies, only the configured ones are deleted.
+	inlinePolicies := d.Get("inline_policy").(*schema.Set).List()
+	if d.Get("force_detach_policies").(bool) {
+		var err error
+		inlinePolicies, err = readIamRoleInlinePolicies(iamconn, d.Id())
+		if err != nil {
+			return fmt.Errorf("Error listing inline policies for IAM Role (%s) when trying to delete: %s", d.Id(), err)
+		}
+	}
+	for _, p := range inlinePolicies {
+		if err := deleteIamRoleInlinePolicy(iamconn, d.Id(), p.(map[string]interface{})["name"].(string)); err != nil {
+			return err
+		}
 	}
 
 	request := &iam.DeleteRoleInput{
@@ -305,3 +453,87 @@ func resourceAwsIamRoleDelete(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	})
 }
+
+func resourceAwsIamRolePutInlinePolicies(conn *iam.IAM, role string, policies []interface{}) error {
+	for _, p := range policies {
+		m := p.(map[string]interface{})
+		name := m["name"].(string)
+
+		log.Printf("[DEBUG] Putting IAM Role (%s) inline policy: %s", role, name)
+		_, err := conn.PutRolePolicy(&iam.PutRolePolicyInput{
+			RoleName:       aws.String(role),
+			PolicyName:     aws.String(name),
+			PolicyDocument: aws.String(m["policy"].(string)),
+		})
+		if err != nil {
+			return fmt.Errorf("Error putting IAM Role (%s) inline policy %s: %s", role, name, err)
+		}
+	}
+	return nil
+}
+
+func deleteIamRoleInlinePolicy(conn *iam.IAM, role, name string) error {
+	log.Printf("[DEBUG] Deleting IAM Role (%s) inline policy: %s", role, name)
+	_, err := conn.DeleteRolePolicy(&iam.DeleteRolePolicyInput{
+		RoleName:   aws.String(role),
+		PolicyName: aws.String(name),
+	})
+	if err != nil && !isAWSErr(err, iam.ErrCodeNoSuchEntityException, "") {
+		return fmt.Errorf("Error deleting IAM Role (%s) inline policy %s: %s", role, name, err)
+	}
+	return nil
+}
+
+// readIamRoleInlinePolicies returns the name and normalized document of
+// every inline policy embedded in the role.
+func readIamRoleInlinePolicies(conn *iam.IAM, role string) ([]interface{}, error) {
+	var names []*string
+	err := conn.ListRolePoliciesPages(&iam.ListRolePoliciesInput{
+		RoleName: aws.String(role),
+	}, func(page *iam.ListRolePoliciesOutput, lastPage bool) bool {
+		names = append(names, page.PolicyNames...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	policies := make([]interface{}, 0, len(names))
+	for _, name := range names {
+		resp, err := conn.GetRolePolicy(&iam.GetRolePolicyInput{
+			RoleName:   aws.String(role),
+			PolicyName: name,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		document, err := url.QueryUnescape(aws.StringValue(resp.PolicyDocument))
+		if err != nil {
+			return nil, err
+		}
+		policy, err := normalizeJsonString(document)
+		if err != nil {
+			return nil, err
+		}
+
+		policies = append(policies, map[string]interface{}{
+			"name":   aws.StringValue(name),
+			"policy": policy,
+		})
+	}
+	return policies, nil
+}
+
+func readIamRoleManagedPolicyArns(conn *iam.IAM, role string) ([]string, error) {
+	var arns []string
+	err := conn.ListAttachedRolePoliciesPages(&iam.ListAttachedRolePoliciesInput{
+		RoleName: aws.String(role),
+	}, func(page *iam.ListAttachedRolePoliciesOutput, lastPage bool) bool {
+		for _, p := range page.AttachedPolicies {
+			arns = append(arns, aws.StringValue(p.PolicyArn))
+		}
+		return !lastPage
+	})
+	return arns, err
+}
@@ -3,11 +3,11 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/iam"
-	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -16,6 +16,9 @@ func resourceAwsIamRolePolicyAttachment() *schema.Resource {
 		Create: resourceAwsIamRolePolicyAttachmentCreate,
 		Read:   resourceAwsIamRolePolicyAttachmentRead,
 		Delete: resourceAwsIamRolePolicyAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsIamRolePolicyAttachmentImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"role": &schema.Schema{
@@ -43,7 +46,7 @@ func resourceAwsIamRolePolicyAttachmentCreate(d *schema.ResourceData, meta inter
 		return fmt.Errorf("[WARN] Error attaching policy %s to IAM Role %s: %v", arn, role, err)
 	}
 
-	d.SetId(resource.PrefixedUniqueId(fmt.Sprintf("%s-", role)))
+	d.SetId(fmt.Sprintf("%s/%s", role, arn))
 	return resourceAwsIamRolePolicyAttachmentRead(d, meta)
 }
 
@@ -103,6 +106,24 @@ func resourceAwsIamRolePolicyAttachmentDelete(d *schema.ResourceData, meta inter
 	return nil
 }
 
+func resourceAwsIamRolePolicyAttachmentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// Role names cannot contain a slash, so everything after the first one
+	// is the policy ARN, which may itself contain a path.
+	idParts := strings.SplitN(d.Id(), "/", 2)
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected <role-name>/<policy-arn>", d.Id())
+	}
+
+	role := idParts[0]
+	arn := idParts[1]
+
+	d.Set("role", role)
+	d.Set("policy_arn", arn)
+	d.SetId(fmt.Sprintf("%s/%s", role, arn))
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func attachPolicyToRole(conn *iam.IAM, role string, arn string) error {
 	_, err := conn.AttachRolePolicy(&iam.AttachRolePolicyInput{
 		RoleName:  aws.String(role),
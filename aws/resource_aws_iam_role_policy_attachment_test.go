@@ -31,6 +31,12 @@ func TestAccAWSRolePolicyAttachment_basic(t *testing.T) {
 					testAccCheckAWSRolePolicyAttachmentAttributes([]string{testPolicy}, &out),
 				),
 			},
+			{
+				ResourceName:      "aws_iam_role_policy_attachment.test-attach",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSIAMRolePolicyAttachmentImportStateIdFunc("aws_iam_role_policy_attachment.test-attach"),
+				ImportStateVerify: true,
+			},
 			{
 				Config: testAccAWSRolePolicyAttachConfigUpdate(rInt),
 				Check: resource.ComposeTestCheckFunc(
@@ -41,6 +47,77 @@ func TestAccAWSRolePolicyAttachment_basic(t *testing.T) {
 		},
 	})
 }
+
+func TestResourceAwsIamRolePolicyAttachmentImport(t *testing.T) {
+	cases := []struct {
+		id        string
+		role      string
+		policyArn string
+		errored   bool
+	}{
+		{
+			id:        "test-role/arn:aws:iam::123456789012:policy/test-policy",
+			role:      "test-role",
+			policyArn: "arn:aws:iam::123456789012:policy/test-policy",
+		},
+		{
+			id:        "test-role/arn:aws:iam::aws:policy/service-role/AmazonEC2RoleforSSM",
+			role:      "test-role",
+			policyArn: "arn:aws:iam::aws:policy/service-role/AmazonEC2RoleforSSM",
+		},
+		{
+			id:      "test-role",
+			errored: true,
+		},
+		{
+			id:      "/arn:aws:iam::123456789012:policy/test-policy",
+			errored: true,
+		},
+		{
+			id:      "test-role/",
+			errored: true,
+		},
+	}
+
+	for _, tc := range cases {
+		d := resourceAwsIamRolePolicyAttachment().TestResourceData()
+		d.SetId(tc.id)
+
+		results, err := resourceAwsIamRolePolicyAttachmentImport(d, nil)
+		if tc.errored {
+			if err == nil {
+				t.Errorf("Expected an error importing %q", tc.id)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Unexpected error importing %q: %s", tc.id, err)
+			continue
+		}
+
+		if len(results) != 1 {
+			t.Fatalf("Expected 1 result importing %q, got %d", tc.id, len(results))
+		}
+		if v := results[0].Get("role").(string); v != tc.role {
+			t.Errorf("Expected role %q importing %q, got %q", tc.role, tc.id, v)
+		}
+		if v := results[0].Get("policy_arn").(string); v != tc.policyArn {
+			t.Errorf("Expected policy_arn %q importing %q, got %q", tc.policyArn, tc.id, v)
+		}
+	}
+}
+
+func testAccAWSIAMRolePolicyAttachmentImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["role"], rs.Primary.Attributes["policy_arn"]), nil
+	}
+}
+
 func testAccCheckAWSRolePolicyAttachmentDestroy(s *terraform.State) error {
 	return nil
 }
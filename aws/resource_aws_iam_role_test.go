@@ -138,6 +138,141 @@ func TestAccAWSIAMRole_badJSON(t *testing.T) {
 	})
 }
 
+func TestAccAWSIAMRole_policiesExclusive(t *testing.T) {
+	var conf iam.GetRoleOutput
+	rName := acctest.RandString(10)
+	resourceName := "aws_iam_role.role"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIAMRoleConfig_policies(rName, "s3:ListBucket"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRoleExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "inline_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "managed_policy_arns.#", "1"),
+				),
+			},
+			{
+				Config: testAccAWSIAMRoleConfig_policies(rName, "s3:GetObject"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRoleExists(resourceName, &conf),
+					testAccCheckAWSRoleInlinePolicyAction(resourceName, "s3:GetObject"),
+					resource.TestCheckResourceAttr(resourceName, "inline_policy.#", "1"),
+				),
+			},
+			{
+				// Policies added outside of Terraform are removed on apply.
+				PreConfig: func() {
+					conn := testAccProvider.Meta().(*AWSClient).iamconn
+					roleName := fmt.Sprintf("test-role-%s", rName)
+					if _, err := conn.PutRolePolicy(&iam.PutRolePolicyInput{
+						RoleName:       aws.String(roleName),
+						PolicyName:     aws.String("out-of-band"),
+						PolicyDocument: aws.String(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"ec2:DescribeTags","Resource":"*"}]}`),
+					}); err != nil {
+						t.Fatalf("error putting out-of-band policy: %s", err)
+					}
+					if err := attachPolicyToRole(conn, roleName, "arn:aws:iam::aws:policy/AmazonEC2ReadOnlyAccess"); err != nil {
+						t.Fatalf("error attaching out-of-band policy: %s", err)
+					}
+				},
+				Config: testAccAWSIAMRoleConfig_policies(rName, "s3:GetObject"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRoleExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "inline_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "managed_policy_arns.#", "1"),
+				),
+			},
+			{
+				Config: testAccAWSIAMRoleConfig_policiesEmpty(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRoleExists(resourceName, &conf),
+					testAccCheckAWSRoleNoPolicies(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "inline_policy.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "managed_policy_arns.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceAwsIamRoleInlinePolicyHash(t *testing.T) {
+	a := map[string]interface{}{
+		"name":   "test",
+		"policy": `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:ListBucket","Resource":"*"}]}`,
+	}
+	b := map[string]interface{}{
+		"name": "test",
+		"policy": `{
+  "Version": "2012-10-17",
+  "Statement": [{"Effect": "Allow", "Action": "s3:ListBucket", "Resource": "*"}]
+}`,
+	}
+	c := map[string]interface{}{
+		"name":   "other",
+		"policy": a["policy"],
+	}
+
+	if resourceAwsIamRoleInlinePolicyHash(a) != resourceAwsIamRoleInlinePolicyHash(b) {
+		t.Fatalf("expected equivalent policies to have the same hash")
+	}
+	if resourceAwsIamRoleInlinePolicyHash(a) == resourceAwsIamRoleInlinePolicyHash(c) {
+		t.Fatalf("expected policies with different names to have different hashes")
+	}
+}
+
+func testAccCheckAWSRoleInlinePolicyAction(n, action string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).iamconn
+		policies, err := readIamRoleInlinePolicies(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		for _, p := range policies {
+			if strings.Contains(p.(map[string]interface{})["policy"].(string), action) {
+				return nil
+			}
+		}
+		return fmt.Errorf("No inline policy of IAM Role %s allows %s", rs.Primary.ID, action)
+	}
+}
+
+func testAccCheckAWSRoleNoPolicies(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).iamconn
+		policies, err := readIamRoleInlinePolicies(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if len(policies) > 0 {
+			return fmt.Errorf("IAM Role %s still has %d inline policies", rs.Primary.ID, len(policies))
+		}
+
+		arns, err := readIamRoleManagedPolicyArns(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if len(arns) > 0 {
+			return fmt.Errorf("IAM Role %s still has attached policies: %v", rs.Primary.ID, arns)
+		}
+		return nil
+	}
+}
+
 func testAccCheckAWSRoleDestroy(s *terraform.State) error {
 	iamconn := testAccProvider.Meta().(*AWSClient).iamconn
 
@@ -375,3 +510,42 @@ POLICY
 }
 `, rName)
 }
+
+func testAccAWSIAMRoleConfig_policies(rName, action string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "role" {
+  name                  = "test-role-%s"
+  assume_role_policy    = "{\"Version\":\"2012-10-17\",\"Statement\":[{\"Effect\":\"Allow\",\"Principal\":{\"Service\":[\"ec2.amazonaws.com\"]},\"Action\":[\"sts:AssumeRole\"]}]}"
+  force_detach_policies = true
+  managed_policy_arns   = ["arn:aws:iam::aws:policy/AmazonS3ReadOnlyAccess"]
+
+  inline_policy {
+    name = "test"
+
+    policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": "%s",
+      "Resource": "*"
+    }
+  ]
+}
+EOF
+  }
+}
+`, rName, action)
+}
+
+func testAccAWSIAMRoleConfig_policiesEmpty(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "role" {
+  name                  = "test-role-%s"
+  assume_role_policy    = "{\"Version\":\"2012-10-17\",\"Statement\":[{\"Effect\":\"Allow\",\"Principal\":{\"Service\":[\"ec2.amazonaws.com\"]},\"Action\":[\"sts:AssumeRole\"]}]}"
+  force_detach_policies = true
+  managed_policy_arns   = []
+}
+`, rName)
+}
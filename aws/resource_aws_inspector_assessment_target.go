@@ -68,6 +68,8 @@ func resourceAwsInspectorAssessmentTargetRead(d *schema.ResourceData, meta inter
 
 	if err != nil {
 		if inspectorerr, ok := err.(awserr.Error); ok && inspectorerr.Code() == "InvalidInputException" {
+			log.Printf("[WARN] Inspector Assessment Target (%s) not found, removing from state", d.Id())
+			d.SetId("")
 			return nil
 		} else {
 			log.Printf("[ERROR] Error finding Inspector Assessment Target: %s", err)
@@ -75,10 +77,14 @@ func resourceAwsInspectorAssessmentTargetRead(d *schema.ResourceData, meta inter
 		}
 	}
 
-	if resp.AssessmentTargets != nil && len(resp.AssessmentTargets) > 0 {
-		d.Set("name", resp.AssessmentTargets[0].Name)
+	if len(resp.AssessmentTargets) == 0 {
+		log.Printf("[WARN] Inspector Assessment Target (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
 	}
 
+	d.Set("name", resp.AssessmentTargets[0].Name)
+
 	return nil
 }
 
@@ -88,6 +88,8 @@ func resourceAwsInspectorAssessmentTemplateRead(d *schema.ResourceData, meta int
 	)
 	if err != nil {
 		if inspectorerr, ok := err.(awserr.Error); ok && inspectorerr.Code() == "InvalidInputException" {
+			log.Printf("[WARN] Inspector Assessment Template (%s) not found, removing from state", d.Id())
+			d.SetId("")
 			return nil
 		} else {
 			log.Printf("[ERROR] Error finding Inspector Assessment Template: %s", err)
@@ -95,9 +97,13 @@ func resourceAwsInspectorAssessmentTemplateRead(d *schema.ResourceData, meta int
 		}
 	}
 
-	if resp.AssessmentTemplates != nil && len(resp.AssessmentTemplates) > 0 {
-		d.Set("name", resp.AssessmentTemplates[0].Name)
+	if len(resp.AssessmentTemplates) == 0 {
+		log.Printf("[WARN] Inspector Assessment Template (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
 	}
+
+	d.Set("name", resp.AssessmentTemplates[0].Name)
 	return nil
 }
 
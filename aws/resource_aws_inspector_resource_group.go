@@ -50,7 +50,7 @@ func resourceAwsInspectorResourceGroupCreate(d *schema.ResourceData, meta interf
 func resourceAwsInspectorResourceGroupRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).inspectorconn
 
-	_, err := conn.DescribeResourceGroups(&inspector.DescribeResourceGroupsInput{
+	resp, err := conn.DescribeResourceGroups(&inspector.DescribeResourceGroupsInput{
 		ResourceGroupArns: []*string{
 			aws.String(d.Id()),
 		},
@@ -58,6 +58,8 @@ func resourceAwsInspectorResourceGroupRead(d *schema.ResourceData, meta interfac
 
 	if err != nil {
 		if inspectorerr, ok := err.(awserr.Error); ok && inspectorerr.Code() == "InvalidInputException" {
+			log.Printf("[WARN] Inspector resource group (%s) not found, removing from state", d.Id())
+			d.SetId("")
 			return nil
 		} else {
 			log.Printf("[ERROR] Error finding Inspector resource group: %s", err)
@@ -65,6 +67,12 @@ func resourceAwsInspectorResourceGroupRead(d *schema.ResourceData, meta interfac
 		}
 	}
 
+	if len(resp.ResourceGroups) == 0 {
+		log.Printf("[WARN] Inspector resource group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
 	return nil
 }
 
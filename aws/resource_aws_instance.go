@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"strconv"
 	"strings"
 	"time"
 
@@ -17,6 +18,8 @@ import (
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/waiter"
 )
 
 func resourceAwsInstance() *schema.Resource {
@@ -26,12 +29,14 @@ func resourceAwsInstance() *schema.Resource {
 		Update: resourceAwsInstanceUpdate,
 		Delete: resourceAwsInstanceDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceAwsInstanceImport,
 		},
 
 		SchemaVersion: 1,
 		MigrateState:  resourceAwsInstanceMigrateState,
 
+		CustomizeDiff: resourceAwsInstanceCustomizeDiff,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
@@ -106,7 +111,6 @@ func resourceAwsInstance() *schema.Resource {
 			"user_data": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ForceNew:      true,
 				ConflictsWith: []string{"user_data_base64"},
 				StateFunc: func(v interface{}) string {
 					switch v.(type) {
@@ -121,7 +125,6 @@ func resourceAwsInstance() *schema.Resource {
 			"user_data_base64": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ForceNew:      true,
 				ConflictsWith: []string{"user_data"},
 				ValidateFunc: func(v interface{}, name string) (warns []string, errs []error) {
 					s := v.(string)
@@ -134,6 +137,12 @@ func resourceAwsInstance() *schema.Resource {
 				},
 			},
 
+			"user_data_replace_on_change": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
 			"security_groups": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -260,6 +269,78 @@ func resourceAwsInstance() *schema.Resource {
 				ForceNew: true,
 			},
 
+			"instance_market_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"market_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								ec2.MarketTypeSpot,
+							}, false),
+						},
+						"spot_options": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"block_duration_minutes": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										ForceNew: true,
+									},
+									"instance_interruption_behavior": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+										ForceNew: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											ec2.InstanceInterruptionBehaviorHibernate,
+											ec2.InstanceInterruptionBehaviorStop,
+											ec2.InstanceInterruptionBehaviorTerminate,
+										}, false),
+									},
+									"max_price": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										Computed:         true,
+										ForceNew:         true,
+										DiffSuppressFunc: suppressEquivalentSpotPrice,
+									},
+									"spot_instance_type": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+										ForceNew: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											ec2.SpotInstanceTypeOneTime,
+											ec2.SpotInstanceTypePersistent,
+										}, false),
+									},
+									"valid_until": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ForceNew:     true,
+										ValidateFunc: validateRFC3339TimeString,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
 			"tags": tagsSchema(),
 
 			"volume_tags": tagsSchemaComputed(),
@@ -300,7 +381,6 @@ func resourceAwsInstance() *schema.Resource {
 							Type:             schema.TypeInt,
 							Optional:         true,
 							Computed:         true,
-							ForceNew:         true,
 							DiffSuppressFunc: iopsDiffSuppressFunc,
 						},
 
@@ -315,14 +395,12 @@ func resourceAwsInstance() *schema.Resource {
 							Type:     schema.TypeInt,
 							Optional: true,
 							Computed: true,
-							ForceNew: true,
 						},
 
 						"volume_type": {
 							Type:     schema.TypeString,
 							Optional: true,
 							Computed: true,
-							ForceNew: true,
 						},
 					},
 				},
@@ -391,7 +469,6 @@ func resourceAwsInstance() *schema.Resource {
 							Type:             schema.TypeInt,
 							Optional:         true,
 							Computed:         true,
-							ForceNew:         true,
 							DiffSuppressFunc: iopsDiffSuppressFunc,
 						},
 
@@ -399,14 +476,12 @@ func resourceAwsInstance() *schema.Resource {
 							Type:     schema.TypeInt,
 							Optional: true,
 							Computed: true,
-							ForceNew: true,
 						},
 
 						"volume_type": {
 							Type:     schema.TypeString,
 							Optional: true,
 							Computed: true,
-							ForceNew: true,
 						},
 					},
 				},
@@ -423,6 +498,12 @@ func iopsDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
 	return strings.ToLower(v) != ec2.VolumeTypeIo1
 }
 
+func resourceAwsInstanceImport(
+	d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("user_data_replace_on_change", true)
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 
@@ -455,6 +536,10 @@ func resourceAwsInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 		UserData:                          instanceOpts.UserData64,
 	}
 
+	if v, ok := d.GetOk("instance_market_options"); ok {
+		runOpts.InstanceMarketOptions = expandInstanceMarketOptionsRequest(v.([]interface{}))
+	}
+
 	_, ipv6CountOk := d.GetOk("ipv6_address_count")
 	_, ipv6AddressOk := d.GetOk("ipv6_addresses")
 
@@ -619,6 +704,14 @@ func resourceAwsInstanceRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("tenancy", instance.Placement.Tenancy)
 	}
 
+	marketOptions, err := readInstanceMarketOptions(conn, instance)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("instance_market_options", marketOptions); err != nil {
+		return fmt.Errorf("Error setting instance_market_options: %s", err)
+	}
+
 	d.Set("ami", instance.ImageId)
 	d.Set("instance_type", instance.InstanceType)
 	d.Set("key_name", instance.KeyName)
@@ -927,57 +1020,54 @@ func resourceAwsInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	if d.HasChange("instance_type") && !d.IsNewResource() {
-		log.Printf("[INFO] Stopping Instance %q for instance_type change", d.Id())
-		_, err := conn.StopInstances(&ec2.StopInstancesInput{
-			InstanceIds: []*string{aws.String(d.Id())},
-		})
-
-		stateConf := &resource.StateChangeConf{
-			Pending:    []string{"pending", "running", "shutting-down", "stopped", "stopping"},
-			Target:     []string{"stopped"},
-			Refresh:    InstanceStateRefreshFunc(conn, d.Id(), ""),
-			Timeout:    d.Timeout(schema.TimeoutUpdate),
-			Delay:      10 * time.Second,
-			MinTimeout: 3 * time.Second,
-		}
-
-		_, err = stateConf.WaitForState()
-		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for instance (%s) to stop: %s", d.Id(), err)
+	userDataChanged := d.HasChange("user_data") || d.HasChange("user_data_base64")
+	if (d.HasChange("instance_type") || userDataChanged) && !d.IsNewResource() {
+		log.Printf("[INFO] Stopping Instance %q for attribute change", d.Id())
+		if err := resourceAwsInstanceStop(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
 		}
 
-		log.Printf("[INFO] Modifying instance type %s", d.Id())
-		_, err = conn.ModifyInstanceAttribute(&ec2.ModifyInstanceAttributeInput{
-			InstanceId: aws.String(d.Id()),
-			InstanceType: &ec2.AttributeValue{
-				Value: aws.String(d.Get("instance_type").(string)),
-			},
-		})
-		if err != nil {
-			return err
+		if d.HasChange("instance_type") {
+			log.Printf("[INFO] Modifying instance type %s", d.Id())
+			_, err := conn.ModifyInstanceAttribute(&ec2.ModifyInstanceAttributeInput{
+				InstanceId: aws.String(d.Id()),
+				InstanceType: &ec2.AttributeValue{
+					Value: aws.String(d.Get("instance_type").(string)),
+				},
+			})
+			if err != nil {
+				return err
+			}
 		}
 
-		log.Printf("[INFO] Starting Instance %q after instance_type change", d.Id())
-		_, err = conn.StartInstances(&ec2.StartInstancesInput{
-			InstanceIds: []*string{aws.String(d.Id())},
-		})
+		if userDataChanged {
+			// Only reached when user_data_replace_on_change is false. When
+			// both are removed from the configuration, the user data is
+			// cleared.
+			userData := []byte(d.Get("user_data").(string))
+			if v := d.Get("user_data_base64").(string); len(userData) == 0 && v != "" {
+				var err error
+				userData, err = base64.StdEncoding.DecodeString(v)
+				if err != nil {
+					return fmt.Errorf("Error decoding user_data_base64: %s", err)
+				}
+			}
 
-		stateConf = &resource.StateChangeConf{
-			Pending:    []string{"pending", "stopped"},
-			Target:     []string{"running"},
-			Refresh:    InstanceStateRefreshFunc(conn, d.Id(), "terminated"),
-			Timeout:    d.Timeout(schema.TimeoutUpdate),
-			Delay:      10 * time.Second,
-			MinTimeout: 3 * time.Second,
+			log.Printf("[INFO] Modifying user data of instance %s", d.Id())
+			_, err := conn.ModifyInstanceAttribute(&ec2.ModifyInstanceAttributeInput{
+				InstanceId: aws.String(d.Id()),
+				UserData: &ec2.BlobAttributeValue{
+					Value: userData,
+				},
+			})
+			if err != nil {
+				return fmt.Errorf("Error modifying user data of instance (%s): %s", d.Id(), err)
+			}
 		}
 
-		_, err = stateConf.WaitForState()
-		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for instance (%s) to become ready: %s",
-				d.Id(), err)
+		log.Printf("[INFO] Starting Instance %q after attribute change", d.Id())
+		if err := resourceAwsInstanceStart(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
 		}
 	}
 
@@ -1006,6 +1096,18 @@ func resourceAwsInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if d.HasChange("root_block_device") && !d.IsNewResource() {
+		if err := resourceAwsInstanceModifyRootBlockDevice(conn, d); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("ebs_block_device") && !d.IsNewResource() {
+		if err := resourceAwsInstanceModifyEbsBlockDevices(conn, d); err != nil {
+			return err
+		}
+	}
+
 	if d.HasChange("monitoring") {
 		var mErr error
 		if d.Get("monitoring").(bool) {
@@ -1032,9 +1134,149 @@ func resourceAwsInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 	return resourceAwsInstanceRead(d, meta)
 }
 
+func resourceAwsInstanceModifyRootBlockDevice(conn *ec2.EC2, d *schema.ResourceData) error {
+	o, n := d.GetChange("root_block_device")
+	if len(o.([]interface{})) == 0 || len(n.([]interface{})) == 0 {
+		return nil
+	}
+
+	instance, err := resourceAwsInstanceFind(conn, d.Id())
+	if err != nil {
+		return err
+	}
+
+	for _, bd := range instance.BlockDeviceMappings {
+		if blockDeviceIsRoot(bd, instance) && bd.Ebs != nil {
+			return modifyInstanceBlockDeviceVolume(conn, aws.StringValue(bd.Ebs.VolumeId),
+				o.([]interface{})[0].(map[string]interface{}), n.([]interface{})[0].(map[string]interface{}), d.Timeout(schema.TimeoutUpdate))
+		}
+	}
+
+	return fmt.Errorf("Error modifying Instance (%s) root block device: root volume not found", d.Id())
+}
+
+func resourceAwsInstanceModifyEbsBlockDevices(conn *ec2.EC2, d *schema.ResourceData) error {
+	o, n := d.GetChange("ebs_block_device")
+
+	// Devices added or removed force a new instance, so only devices present
+	// in both the old and new configuration are modified here.
+	oldDevices := make(map[string]map[string]interface{})
+	for _, v := range o.(*schema.Set).List() {
+		m := v.(map[string]interface{})
+		oldDevices[m["device_name"].(string)] = m
+	}
+
+	instance, err := resourceAwsInstanceFind(conn, d.Id())
+	if err != nil {
+		return err
+	}
+
+	volumeIds := make(map[string]string)
+	for _, bd := range instance.BlockDeviceMappings {
+		if bd.Ebs != nil {
+			volumeIds[aws.StringValue(bd.DeviceName)] = aws.StringValue(bd.Ebs.VolumeId)
+		}
+	}
+
+	for _, v := range n.(*schema.Set).List() {
+		newDevice := v.(map[string]interface{})
+		deviceName := newDevice["device_name"].(string)
+
+		oldDevice, ok := oldDevices[deviceName]
+		if !ok {
+			continue
+		}
+
+		volumeId, ok := volumeIds[deviceName]
+		if !ok {
+			return fmt.Errorf("Error modifying Instance (%s) block device %s: volume not found", d.Id(), deviceName)
+		}
+
+		if err := modifyInstanceBlockDeviceVolume(conn, volumeId, oldDevice, newDevice, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceAwsInstanceFind(conn *ec2.EC2, id string) (*ec2.Instance, error) {
+	resp, err := conn.DescribeInstances(&ec2.DescribeInstancesInput{
+		InstanceIds: []*string{aws.String(id)},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error describing Instance (%s): %s", id, err)
+	}
+
+	if len(resp.Reservations) == 0 || len(resp.Reservations[0].Instances) == 0 {
+		return nil, fmt.Errorf("Instance (%s) not found", id)
+	}
+
+	return resp.Reservations[0].Instances[0], nil
+}
+
+// modifyInstanceBlockDeviceVolume modifies the size, type and IOPS of an
+// attached EBS volume in place, waiting until the new settings are usable.
+func modifyInstanceBlockDeviceVolume(conn *ec2.EC2, volumeId string, o, n map[string]interface{}, timeout time.Duration) error {
+	input := &ec2.ModifyVolumeInput{
+		VolumeId: aws.String(volumeId),
+	}
+	modify := false
+
+	if v := n["volume_size"].(int); v != o["volume_size"].(int) && v > 0 {
+		input.Size = aws.Int64(int64(v))
+		modify = true
+	}
+	if v := n["volume_type"].(string); v != o["volume_type"].(string) && v != "" {
+		input.VolumeType = aws.String(v)
+		modify = true
+	}
+	// IOPS can only be provisioned for io1 volumes, and must be given again
+	// when changing the type of a volume to io1.
+	if v := n["iops"].(int); v > 0 && strings.ToLower(n["volume_type"].(string)) == ec2.VolumeTypeIo1 {
+		if v != o["iops"].(int) || input.VolumeType != nil {
+			input.Iops = aws.Int64(int64(v))
+			modify = true
+		}
+	}
+
+	if !modify {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Modifying EBS Volume: %s", input)
+	if _, err := conn.ModifyVolume(input); err != nil {
+		return fmt.Errorf("Error modifying EBS Volume (%s): %s", volumeId, err)
+	}
+
+	if _, err := waiter.VolumeModificationOptimizing(conn, volumeId, timeout); err != nil {
+		return fmt.Errorf("Error waiting for EBS Volume (%s) modification: %s", volumeId, err)
+	}
+
+	return nil
+}
+
 func resourceAwsInstanceDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 
+	// A persistent Spot request launches a new instance when its instance is
+	// terminated, so the request is cancelled first.
+	if v := d.Get("instance_market_options").([]interface{}); len(v) > 0 {
+		instance, err := resourceAwsInstanceFind(conn, d.Id())
+		if err != nil {
+			return err
+		}
+		if instance.SpotInstanceRequestId != nil {
+			log.Printf("[INFO] Cancelling Spot request %s of Instance %s", aws.StringValue(instance.SpotInstanceRequestId), d.Id())
+			_, err := conn.CancelSpotInstanceRequests(&ec2.CancelSpotInstanceRequestsInput{
+				SpotInstanceRequestIds: []*string{instance.SpotInstanceRequestId},
+			})
+			if err != nil && !isAWSErr(err, "InvalidSpotInstanceRequestID.NotFound", "") {
+				return fmt.Errorf("Error cancelling Spot request of Instance (%s): %s", d.Id(), err)
+			}
+		}
+	}
+
 	if err := awsTerminateInstance(conn, d.Id(), d); err != nil {
 		return err
 	}
@@ -1717,6 +1959,169 @@ func iamInstanceProfileArnToName(ip *ec2.IamInstanceProfile) string {
 	return parts[len(parts)-1]
 }
 
+func expandInstanceMarketOptionsRequest(l []interface{}) *ec2.InstanceMarketOptionsRequest {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	options := &ec2.InstanceMarketOptionsRequest{
+		MarketType: aws.String(ec2.MarketTypeSpot),
+	}
+
+	if v, ok := m["market_type"].(string); ok && v != "" {
+		options.MarketType = aws.String(v)
+	}
+
+	if v, ok := m["spot_options"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		so := v[0].(map[string]interface{})
+		spotOptions := &ec2.SpotMarketOptions{}
+
+		if v, ok := so["block_duration_minutes"].(int); ok && v != 0 {
+			spotOptions.BlockDurationMinutes = aws.Int64(int64(v))
+		}
+		if v, ok := so["instance_interruption_behavior"].(string); ok && v != "" {
+			spotOptions.InstanceInterruptionBehavior = aws.String(v)
+		}
+		if v, ok := so["max_price"].(string); ok && v != "" {
+			spotOptions.MaxPrice = aws.String(v)
+		}
+		if v, ok := so["spot_instance_type"].(string); ok && v != "" {
+			spotOptions.SpotInstanceType = aws.String(v)
+		}
+		if v, ok := so["valid_until"].(string); ok && v != "" {
+			t, _ := time.Parse(time.RFC3339, v)
+			spotOptions.ValidUntil = aws.Time(t)
+		}
+
+		options.SpotOptions = spotOptions
+	}
+
+	return options
+}
+
+// readInstanceMarketOptions returns the market options of a Spot Instance,
+// which are only available from the Spot request that launched it.
+func readInstanceMarketOptions(conn *ec2.EC2, instance *ec2.Instance) ([]interface{}, error) {
+	if aws.StringValue(instance.InstanceLifecycle) != ec2.InstanceLifecycleTypeSpot || instance.SpotInstanceRequestId == nil {
+		return []interface{}{}, nil
+	}
+
+	resp, err := conn.DescribeSpotInstanceRequests(&ec2.DescribeSpotInstanceRequestsInput{
+		SpotInstanceRequestIds: []*string{instance.SpotInstanceRequestId},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error describing Spot request of Instance (%s): %s", aws.StringValue(instance.InstanceId), err)
+	}
+	if len(resp.SpotInstanceRequests) == 0 {
+		return []interface{}{}, nil
+	}
+
+	request := resp.SpotInstanceRequests[0]
+	spotOptions := map[string]interface{}{
+		"block_duration_minutes":         int(aws.Int64Value(request.BlockDurationMinutes)),
+		"instance_interruption_behavior": aws.StringValue(request.InstanceInterruptionBehavior),
+		"max_price":                      aws.StringValue(request.SpotPrice),
+		"spot_instance_type":             aws.StringValue(request.Type),
+	}
+	if request.ValidUntil != nil {
+		spotOptions["valid_until"] = aws.TimeValue(request.ValidUntil).Format(time.RFC3339)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"market_type":  ec2.MarketTypeSpot,
+			"spot_options": []interface{}{spotOptions},
+		},
+	}, nil
+}
+
+// suppressEquivalentSpotPrice suppresses differences between prices such as
+// "0.05" and the "0.050000" returned by EC2.
+func suppressEquivalentSpotPrice(k, old, new string, d *schema.ResourceData) bool {
+	o, err := strconv.ParseFloat(old, 64)
+	if err != nil {
+		return false
+	}
+	n, err := strconv.ParseFloat(new, 64)
+	if err != nil {
+		return false
+	}
+	return o == n
+}
+
+// resourceAwsInstanceCustomizeDiff forces a new instance when the user data
+// changes, unless the instance is set up to have its user data modified in
+// place.
+func resourceAwsInstanceCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.Get("user_data_replace_on_change").(bool) {
+		return nil
+	}
+
+	for _, k := range []string{"user_data", "user_data_base64"} {
+		if diff.HasChange(k) {
+			if err := diff.ForceNew(k); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceAwsInstanceStop(conn *ec2.EC2, id string, timeout time.Duration) error {
+	_, err := conn.StopInstances(&ec2.StopInstancesInput{
+		InstanceIds: []*string{aws.String(id)},
+	})
+	if err != nil {
+		return fmt.Errorf("Error stopping instance (%s): %s", id, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"pending", "running", "shutting-down", "stopped", "stopping"},
+		Target:     []string{"stopped"},
+		Refresh:    InstanceStateRefreshFunc(conn, id, ""),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf(
+			"Error waiting for instance (%s) to stop: %s", id, err)
+	}
+
+	return nil
+}
+
+func resourceAwsInstanceStart(conn *ec2.EC2, id string, timeout time.Duration) error {
+	_, err := conn.StartInstances(&ec2.StartInstancesInput{
+		InstanceIds: []*string{aws.String(id)},
+	})
+	if err != nil {
+		return fmt.Errorf("Error starting instance (%s): %s", id, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"pending", "stopped"},
+		Target:     []string{"running"},
+		Refresh:    InstanceStateRefreshFunc(conn, id, "terminated"),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf(
+			"Error waiting for instance (%s) to become ready: %s",
+			id, err)
+	}
+
+	return nil
+}
+
 func userDataHashSum(user_data string) string {
 	// Check whether the user_data is not Base64 encoded.
 	// Always calculate hash of base64 decoded value since we
@@ -143,6 +143,113 @@ func TestAccAWSInstance_userDataBase64(t *testing.T) {
 	})
 }
 
+func TestAccAWSInstance_userDataUpdateInPlace(t *testing.T) {
+	var before, after ec2.Instance
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfigUserDataInPlace(rInt, "hello world"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists("aws_instance.foo", &before),
+					resource.TestCheckResourceAttr("aws_instance.foo", "user_data_base64", "aGVsbG8gd29ybGQ="),
+				),
+			},
+			{
+				Config: testAccInstanceConfigUserDataInPlace(rInt, "goodbye world"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists("aws_instance.foo", &after),
+					testAccCheckInstanceNotRecreated(t, &before, &after),
+					resource.TestCheckResourceAttr("aws_instance.foo", "user_data_base64", "Z29vZGJ5ZSB3b3JsZA=="),
+				),
+			},
+			{
+				Config: testAccInstanceConfigUserDataInPlaceRemoved(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists("aws_instance.foo", &after),
+					testAccCheckInstanceNotRecreated(t, &before, &after),
+					testAccCheckInstanceUserDataEmpty(&after),
+					resource.TestCheckResourceAttr("aws_instance.foo", "user_data", ""),
+					resource.TestCheckResourceAttr("aws_instance.foo", "user_data_base64", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSInstance_userDataReplaceOnChange(t *testing.T) {
+	var before, after ec2.Instance
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfigWithUserDataBase64(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists("aws_instance.foo", &before),
+				),
+			},
+			{
+				Config: testAccInstanceConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists("aws_instance.foo", &after),
+					testAccCheckInstanceRecreated(t, &before, &after),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSInstance_instanceMarketOptions(t *testing.T) {
+	var v ec2.Instance
+	resourceName := "aws_instance.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfigInstanceMarketOptions,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "instance_market_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "instance_market_options.0.market_type", "spot"),
+					resource.TestCheckResourceAttr(resourceName, "instance_market_options.0.spot_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "instance_market_options.0.spot_options.0.instance_interruption_behavior", "stop"),
+					resource.TestCheckResourceAttr(resourceName, "instance_market_options.0.spot_options.0.spot_instance_type", "persistent"),
+					resource.TestCheckResourceAttrSet(resourceName, "instance_market_options.0.spot_options.0.valid_until"),
+				),
+			},
+		},
+	})
+}
+
+func TestSuppressEquivalentSpotPrice(t *testing.T) {
+	cases := []struct {
+		Old, New string
+		Equal    bool
+	}{
+		{"0.050000", "0.05", true},
+		{"0.05", "0.05", true},
+		{"0.050000", "0.06", false},
+		{"", "0.05", false},
+	}
+
+	for _, tc := range cases {
+		if suppressEquivalentSpotPrice("max_price", tc.Old, tc.New, nil) != tc.Equal {
+			t.Fatalf("expected %q and %q equivalence to be %t", tc.Old, tc.New, tc.Equal)
+		}
+	}
+}
+
 func TestAccAWSInstance_GP2IopsDevice(t *testing.T) {
 	var v ec2.Instance
 
@@ -1075,6 +1182,37 @@ func TestAccAWSInstance_changeInstanceType(t *testing.T) {
 	})
 }
 
+func TestAccAWSInstance_blockDeviceModify(t *testing.T) {
+	var before ec2.Instance
+	var after ec2.Instance
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfigBlockDeviceModify(11, "gp2", 9),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists("aws_instance.foo", &before),
+					resource.TestCheckResourceAttr("aws_instance.foo", "root_block_device.0.volume_size", "11"),
+					resource.TestCheckResourceAttr("aws_instance.foo", "root_block_device.0.volume_type", "gp2"),
+				),
+			},
+			{
+				Config: testAccInstanceConfigBlockDeviceModify(12, "standard", 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists("aws_instance.foo", &after),
+					testAccCheckInstanceNotRecreated(t, &before, &after),
+					resource.TestCheckResourceAttr("aws_instance.foo", "root_block_device.0.volume_size", "12"),
+					resource.TestCheckResourceAttr("aws_instance.foo", "root_block_device.0.volume_type", "standard"),
+					resource.TestCheckResourceAttr("aws_instance.foo", "ebs_block_device.#", "1"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSInstance_primaryNetworkInterface(t *testing.T) {
 	var instance ec2.Instance
 	var ini ec2.NetworkInterface
@@ -1324,6 +1462,35 @@ func testAccCheckInstanceNotRecreated(t *testing.T,
 	}
 }
 
+func testAccCheckInstanceUserDataEmpty(instance *ec2.Instance) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		attr, err := conn.DescribeInstanceAttribute(&ec2.DescribeInstanceAttributeInput{
+			Attribute:  aws.String(ec2.InstanceAttributeNameUserData),
+			InstanceId: instance.InstanceId,
+		})
+		if err != nil {
+			return err
+		}
+
+		if attr.UserData != nil && aws.StringValue(attr.UserData.Value) != "" {
+			return fmt.Errorf("AWS Instance (%s) user data not cleared", aws.StringValue(instance.InstanceId))
+		}
+		return nil
+	}
+}
+
+func testAccCheckInstanceRecreated(t *testing.T,
+	before, after *ec2.Instance) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if *before.InstanceId == *after.InstanceId {
+			t.Fatalf("AWS Instance (%s) not recreated", *before.InstanceId)
+		}
+		return nil
+	}
+}
+
 func testAccCheckInstanceDestroy(s *terraform.State) error {
 	return testAccCheckInstanceDestroyWithProvider(s, testAccProvider)
 }
@@ -1517,6 +1684,67 @@ resource "aws_instance" "foo" {
 `, rInt)
 }
 
+func testAccInstanceConfigUserDataInPlace(rInt int, userData string) string {
+	return fmt.Sprintf(`
+resource "aws_security_group" "tf_test_foo" {
+	name = "tf_test_%d"
+	description = "foo"
+}
+
+resource "aws_instance" "foo" {
+	# us-west-2
+	ami = "ami-55a7ea65"
+	availability_zone = "us-west-2a"
+
+	instance_type = "m3.medium"
+	security_groups = ["${aws_security_group.tf_test_foo.name}"]
+	user_data_base64 = "${base64encode("%s")}"
+	user_data_replace_on_change = false
+}
+`, rInt, userData)
+}
+
+func testAccInstanceConfigUserDataInPlaceRemoved(rInt int) string {
+	return fmt.Sprintf(`
+resource "aws_security_group" "tf_test_foo" {
+	name = "tf_test_%d"
+	description = "foo"
+}
+
+resource "aws_instance" "foo" {
+	# us-west-2
+	ami = "ami-55a7ea65"
+	availability_zone = "us-west-2a"
+
+	instance_type = "m3.medium"
+	security_groups = ["${aws_security_group.tf_test_foo.name}"]
+	user_data_replace_on_change = false
+}
+`, rInt)
+}
+
+const testAccInstanceConfigInstanceMarketOptions = `
+resource "aws_instance" "foo" {
+	# us-west-2
+	ami = "ami-55a7ea65"
+	availability_zone = "us-west-2a"
+
+	instance_type = "m3.medium"
+
+	instance_market_options {
+		spot_options {
+			instance_interruption_behavior = "stop"
+			max_price = "0.05"
+			spot_instance_type = "persistent"
+		}
+	}
+
+	tags {
+	    Name = "tf-acctest"
+	}
+}
+`
+
 const testAccInstanceConfigWithSmallInstanceType = `
 resource "aws_instance" "foo" {
 	# us-west-2
@@ -1620,6 +1848,26 @@ resource "aws_instance" "foo" {
 }
 `
 
+func testAccInstanceConfigBlockDeviceModify(rootSize int, rootType string, ebsSize int) string {
+	return fmt.Sprintf(`
+resource "aws_instance" "foo" {
+	# us-west-2
+	ami = "ami-55a7ea65"
+	instance_type = "m3.medium"
+
+	root_block_device {
+		volume_type = "%s"
+		volume_size = %d
+	}
+
+	ebs_block_device {
+		device_name = "/dev/sdb"
+		volume_size = %d
+	}
+}
+`, rootType, rootSize, ebsSize)
+}
+
 const testAccInstanceConfigSourceDestEnable = `
 resource "aws_vpc" "foo" {
 	cidr_block = "10.1.0.0/16"
@@ -60,6 +60,11 @@ func resourceAwsIotCertificateRead(d *schema.ResourceData, meta interface{}) err
 	})
 
 	if err != nil {
+		if isAWSErr(err, iot.ErrCodeResourceNotFoundException, "") {
+			log.Printf("[WARN] IoT Certificate (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
 		log.Printf("[ERROR] %s", err)
 		return err
 	}
@@ -62,6 +62,11 @@ func resourceAwsIotPolicyRead(d *schema.ResourceData, meta interface{}) error {
 	})
 
 	if err != nil {
+		if isAWSErr(err, iot.ErrCodeResourceNotFoundException, "") {
+			log.Printf("[WARN] IoT Policy (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
 		log.Printf("[ERROR] %s", err)
 		return err
 	}
@@ -0,0 +1,334 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// kmsGrantPropagationTimeout is how long to retry KMS grant operations while
+// grants and newly created grantee principals propagate.
+const kmsGrantPropagationTimeout = 3 * time.Minute
+
+func resourceAwsKmsGrant() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsKmsGrantCreate,
+		Read:   resourceAwsKmsGrantRead,
+		Delete: resourceAwsKmsGrantDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsKmsGrantName,
+			},
+			"key_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"grantee_principal": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"operations": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				Set:      schema.HashString,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						kms.GrantOperationCreateGrant,
+						kms.GrantOperationDecrypt,
+						kms.GrantOperationDescribeKey,
+						kms.GrantOperationEncrypt,
+						kms.GrantOperationGenerateDataKey,
+						kms.GrantOperationGenerateDataKeyWithoutPlaintext,
+						kms.GrantOperationReEncryptFrom,
+						kms.GrantOperationReEncryptTo,
+						kms.GrantOperationRetireGrant,
+					}, false),
+				},
+			},
+			"constraints": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"encryption_context_equals": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"encryption_context_subset": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"retiring_principal": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"grant_creation_tokens": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"retire_on_delete": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				ForceNew: true,
+			},
+			"grant_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"grant_token": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func resourceAwsKmsGrantCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kmsconn
+	keyId := d.Get("key_id").(string)
+
+	input := &kms.CreateGrantInput{
+		GranteePrincipal: aws.String(d.Get("grantee_principal").(string)),
+		KeyId:            aws.String(keyId),
+		Operations:       expandStringList(d.Get("operations").(*schema.Set).List()),
+	}
+
+	// A grant created with the same name and parameters as an existing grant
+	// returns the existing grant, which makes retried requests idempotent.
+	if v, ok := d.GetOk("name"); ok {
+		input.Name = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("constraints"); ok {
+		input.Constraints = expandKmsGrantConstraints(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("retiring_principal"); ok {
+		input.RetiringPrincipal = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("grant_creation_tokens"); ok {
+		input.GrantTokens = expandStringList(v.(*schema.Set).List())
+	}
+
+	log.Printf("[DEBUG] Creating KMS Grant: %s", input)
+	var output *kms.CreateGrantOutput
+	err := resource.Retry(kmsGrantPropagationTimeout, func() *resource.RetryError {
+		var err error
+		output, err = conn.CreateGrant(input)
+		if err != nil {
+			// The grantee or retiring principal may have just been created
+			// and not yet be visible to KMS.
+			if isAWSErr(err, kms.ErrCodeInvalidArnException, "") || isAWSErr(err, kms.ErrCodeNotFoundException, "") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating KMS Grant for key (%s): %s", keyId, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", keyId, aws.StringValue(output.GrantId)))
+	d.Set("grant_token", output.GrantToken)
+
+	return resourceAwsKmsGrantRead(d, meta)
+}
+
+func resourceAwsKmsGrantRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kmsconn
+
+	keyId, grantId, err := decodeKmsGrantId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	grant, err := findKmsGrantById(conn, keyId, grantId)
+	if err != nil {
+		if isAWSErr(err, kms.ErrCodeNotFoundException, "") {
+			log.Printf("[WARN] KMS Key (%s) not found, removing grant %s from state", keyId, grantId)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading KMS Grant (%s): %s", d.Id(), err)
+	}
+
+	if grant == nil {
+		log.Printf("[WARN] KMS Grant (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("key_id", keyId)
+	d.Set("grant_id", grant.GrantId)
+	d.Set("name", grant.Name)
+	if err := d.Set("operations", aws.StringValueSlice(grant.Operations)); err != nil {
+		return fmt.Errorf("Error setting operations: %s", err)
+	}
+	if err := d.Set("constraints", flattenKmsGrantConstraints(grant.Constraints)); err != nil {
+		return fmt.Errorf("Error setting constraints: %s", err)
+	}
+
+	// KMS returns the unique ID of a principal instead of its ARN once the
+	// principal has been deleted, so only an ARN is saved to state.
+	if v := aws.StringValue(grant.GranteePrincipal); strings.HasPrefix(v, "arn:") {
+		d.Set("grantee_principal", v)
+	}
+	if v := aws.StringValue(grant.RetiringPrincipal); strings.HasPrefix(v, "arn:") {
+		d.Set("retiring_principal", v)
+	}
+
+	return nil
+}
+
+func resourceAwsKmsGrantDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kmsconn
+
+	keyId, grantId, err := decodeKmsGrantId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.Get("retire_on_delete").(bool) {
+		// RetireGrant requires the ARN of the key, which is returned when
+		// listing the key's grants.
+		var grant *kms.GrantListEntry
+		grant, err = findKmsGrantById(conn, keyId, grantId)
+		if err != nil {
+			if isAWSErr(err, kms.ErrCodeNotFoundException, "") {
+				return nil
+			}
+			return fmt.Errorf("Error reading KMS Grant (%s): %s", d.Id(), err)
+		}
+		if grant == nil {
+			return nil
+		}
+
+		log.Printf("[DEBUG] Retiring KMS Grant: %s", d.Id())
+		_, err = conn.RetireGrant(&kms.RetireGrantInput{
+			GrantId: aws.String(grantId),
+			KeyId:   grant.KeyId,
+		})
+	} else {
+		log.Printf("[DEBUG] Revoking KMS Grant: %s", d.Id())
+		_, err = conn.RevokeGrant(&kms.RevokeGrantInput{
+			GrantId: aws.String(grantId),
+			KeyId:   aws.String(keyId),
+		})
+	}
+	if err != nil {
+		if isAWSErr(err, kms.ErrCodeNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting KMS Grant (%s): %s", d.Id(), err)
+	}
+
+	// Grants are eventually consistent and remain listed for a short time.
+	return resource.Retry(kmsGrantPropagationTimeout, func() *resource.RetryError {
+		grant, err := findKmsGrantById(conn, keyId, grantId)
+		if err != nil {
+			if isAWSErr(err, kms.ErrCodeNotFoundException, "") {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+		if grant != nil {
+			return resource.RetryableError(fmt.Errorf("KMS Grant (%s) still exists", d.Id()))
+		}
+		return nil
+	})
+}
+
+// findKmsGrantById returns the grant of the key with the given ID, or nil if
+// the key has no such grant.
+func findKmsGrantById(conn *kms.KMS, keyId, grantId string) (*kms.GrantListEntry, error) {
+	var grant *kms.GrantListEntry
+
+	err := conn.ListGrantsPages(&kms.ListGrantsInput{
+		KeyId: aws.String(keyId),
+	}, func(page *kms.ListGrantsResponse, lastPage bool) bool {
+		for _, g := range page.Grants {
+			if aws.StringValue(g.GrantId) == grantId {
+				grant = g
+				return false
+			}
+		}
+		return !lastPage
+	})
+
+	return grant, err
+}
+
+// decodeKmsGrantId splits a grant's resource ID into the key ID, which may be
+// an ARN containing colons, and the grant ID.
+func decodeKmsGrantId(id string) (string, string, error) {
+	i := strings.LastIndex(id, ":")
+	if i < 1 || i == len(id)-1 {
+		return "", "", fmt.Errorf("Unexpected format of ID (%q), expected KEY-ID:GRANT-ID", id)
+	}
+	return id[:i], id[i+1:], nil
+}
+
+func expandKmsGrantConstraints(l []interface{}) *kms.GrantConstraints {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	constraints := &kms.GrantConstraints{}
+
+	if v, ok := m["encryption_context_equals"].(map[string]interface{}); ok && len(v) > 0 {
+		constraints.EncryptionContextEquals = stringMapToPointers(v)
+	}
+	if v, ok := m["encryption_context_subset"].(map[string]interface{}); ok && len(v) > 0 {
+		constraints.EncryptionContextSubset = stringMapToPointers(v)
+	}
+
+	return constraints
+}
+
+func flattenKmsGrantConstraints(constraints *kms.GrantConstraints) []interface{} {
+	if constraints == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"encryption_context_equals": pointersMapToStringList(constraints.EncryptionContextEquals),
+			"encryption_context_subset": pointersMapToStringList(constraints.EncryptionContextSubset),
+		},
+	}
+}
@@ -0,0 +1,207 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestDecodeKmsGrantId(t *testing.T) {
+	cases := []struct {
+		Id      string
+		KeyId   string
+		GrantId string
+		Error   bool
+	}{
+		{
+			Id:      "1234abcd-12ab-34cd-56ef-1234567890ab:abcde1237f76e4ba7987489ac329fbfba6ad343d6f7075dbd1ef191f0120514",
+			KeyId:   "1234abcd-12ab-34cd-56ef-1234567890ab",
+			GrantId: "abcde1237f76e4ba7987489ac329fbfba6ad343d6f7075dbd1ef191f0120514",
+		},
+		{
+			Id:      "arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab:abcde123",
+			KeyId:   "arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+			GrantId: "abcde123",
+		},
+		{
+			Id:    "1234abcd-12ab-34cd-56ef-1234567890ab",
+			Error: true,
+		},
+		{
+			Id:    "1234abcd-12ab-34cd-56ef-1234567890ab:",
+			Error: true,
+		},
+	}
+
+	for _, tc := range cases {
+		keyId, grantId, err := decodeKmsGrantId(tc.Id)
+		if tc.Error {
+			if err == nil {
+				t.Fatalf("expected error decoding %q", tc.Id)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("unexpected error decoding %q: %s", tc.Id, err)
+		}
+		if keyId != tc.KeyId || grantId != tc.GrantId {
+			t.Fatalf("decoding %q: expected (%s, %s), got (%s, %s)", tc.Id, tc.KeyId, tc.GrantId, keyId, grantId)
+		}
+	}
+}
+
+func TestAccAWSKmsGrant_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_kms_grant.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSKmsGrantDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSKmsGrantConfig(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKmsGrantExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "operations.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "constraints.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "constraints.0.encryption_context_equals.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "constraints.0.encryption_context_equals.foo", "bar"),
+					resource.TestCheckResourceAttrSet(resourceName, "grant_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "grant_token"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"grant_token", "retire_on_delete"},
+			},
+		},
+	})
+}
+
+func TestAccAWSKmsGrant_retireOnDelete(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_kms_grant.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSKmsGrantDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSKmsGrantConfig(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKmsGrantExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "retire_on_delete", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSKmsGrantExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No KMS Grant ID is set")
+		}
+
+		keyId, grantId, err := decodeKmsGrantId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).kmsconn
+		grant, err := findKmsGrantById(conn, keyId, grantId)
+		if err != nil {
+			return err
+		}
+		if grant == nil {
+			return fmt.Errorf("KMS Grant (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSKmsGrantDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).kmsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_kms_grant" {
+			continue
+		}
+
+		keyId, grantId, err := decodeKmsGrantId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		grant, err := findKmsGrantById(conn, keyId, grantId)
+		if err != nil {
+			if isAWSErr(err, kms.ErrCodeNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+		if grant != nil {
+			return fmt.Errorf("KMS Grant (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSKmsGrantConfig(rName string, retireOnDelete bool) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {"Service": "ec2.amazonaws.com"},
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_kms_grant" "test" {
+  name               = %[1]q
+  key_id             = "${aws_kms_key.test.key_id}"
+  grantee_principal  = "${aws_iam_role.test.arn}"
+  retiring_principal = "arn:aws:iam::${data.aws_caller_identity.current.account_id}:root"
+  operations         = ["Encrypt", "Decrypt"]
+  retire_on_delete   = %[2]t
+
+  constraints {
+    encryption_context_equals {
+      foo = "bar"
+    }
+  }
+}
+`, rName, retireOnDelete)
+}
@@ -195,7 +195,7 @@ func resourceAwsLambdaFunction() *schema.Resource {
 			"kms_key_arn": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: validateArn,
+				ValidateFunc: validateKmsKey,
 			},
 
 			"tags": tagsSchema(),
@@ -328,7 +328,12 @@ func resourceAwsLambdaFunctionCreate(d *schema.ResourceData, meta interface{}) e
 	}
 
 	if v, ok := d.GetOk("kms_key_arn"); ok {
-		params.KMSKeyArn = aws.String(v.(string))
+		// Lambda only accepts key ARNs, aliases are resolved beforehand.
+		arn, err := resolveKmsKeyArn(meta.(*AWSClient).kmsconn, v.(string))
+		if err != nil {
+			return err
+		}
+		params.KMSKeyArn = aws.String(arn)
 	}
 
 	if v, exists := d.GetOk("tags"); exists {
@@ -415,7 +420,7 @@ func resourceAwsLambdaFunctionRead(d *schema.ResourceData, meta interface{}) err
 	d.Set("role", function.Role)
 	d.Set("runtime", function.Runtime)
 	d.Set("timeout", function.Timeout)
-	d.Set("kms_key_arn", function.KMSKeyArn)
+	d.Set("kms_key_arn", kmsKeyIdStateValue(d.Get("kms_key_arn").(string), aws.StringValue(function.KMSKeyArn)))
 	d.Set("tags", tagsToMapGeneric(getFunctionOutput.Tags))
 
 	config := flattenLambdaVpcConfigResponse(function.VpcConfig)
@@ -560,7 +565,14 @@ func resourceAwsLambdaFunctionUpdate(d *schema.ResourceData, meta interface{}) e
 		configUpdate = true
 	}
 	if d.HasChange("kms_key_arn") {
-		configReq.KMSKeyArn = aws.String(d.Get("kms_key_arn").(string))
+		configReq.KMSKeyArn = aws.String("")
+		if v, ok := d.GetOk("kms_key_arn"); ok {
+			arn, err := resolveKmsKeyArn(meta.(*AWSClient).kmsconn, v.(string))
+			if err != nil {
+				return err
+			}
+			configReq.KMSKeyArn = aws.String(arn)
+		}
 		configUpdate = true
 	}
 	if d.HasChange("dead_letter_config") {
@@ -0,0 +1,1172 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsLaunchTemplate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLaunchTemplateCreate,
+		Read:   resourceAwsLaunchTemplateRead,
+		Update: resourceAwsLaunchTemplateUpdate,
+		Delete: resourceAwsLaunchTemplateDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: resourceAwsLaunchTemplateCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"name_prefix"},
+				ValidateFunc:  validateLaunchTemplateName,
+			},
+
+			"name_prefix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateLaunchTemplateNamePrefix,
+			},
+
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"default_version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"latest_version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"update_default_version": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"block_device_mappings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"device_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"no_device": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"virtual_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"ebs": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"delete_on_termination": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"encrypted": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"iops": {
+										Type:     schema.TypeInt,
+										Computed: true,
+										Optional: true,
+									},
+									"kms_key_id": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validateArn,
+									},
+									"snapshot_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"volume_size": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										Computed: true,
+									},
+									"volume_type": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											ec2.VolumeTypeStandard,
+											ec2.VolumeTypeIo1,
+											ec2.VolumeTypeGp2,
+											ec2.VolumeTypeSc1,
+											ec2.VolumeTypeSt1,
+										}, false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"credit_specification": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cpu_credits": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"standard",
+								"unlimited",
+							}, false),
+						},
+					},
+				},
+			},
+
+			"disable_api_termination": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"ebs_optimized": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"elastic_gpu_specifications": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+
+			"iam_instance_profile": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							ConflictsWith: []string{"iam_instance_profile.0.name"},
+							ValidateFunc:  validateArn,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"image_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"instance_initiated_shutdown_behavior": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					ec2.ShutdownBehaviorStop,
+					ec2.ShutdownBehaviorTerminate,
+				}, false),
+			},
+
+			"instance_market_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"market_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								ec2.MarketTypeSpot,
+							}, false),
+						},
+						"spot_options": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"block_duration_minutes": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+									"instance_interruption_behavior": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											ec2.InstanceInterruptionBehaviorHibernate,
+											ec2.InstanceInterruptionBehaviorStop,
+											ec2.InstanceInterruptionBehaviorTerminate,
+										}, false),
+									},
+									"max_price": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"spot_instance_type": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											ec2.SpotInstanceTypeOneTime,
+											ec2.SpotInstanceTypePersistent,
+										}, false),
+									},
+									"valid_until": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validateRFC3339TimeString,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"instance_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"kernel_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"key_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"monitoring": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"network_interfaces": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"associate_public_ip_address": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"delete_on_termination": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"device_index": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"security_groups": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+						"ipv6_address_count": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"ipv6_addresses": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+						"network_interface_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"private_ip_address": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"ipv4_addresses": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+						"ipv4_address_count": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"placement": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"affinity": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"availability_zone": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"group_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"host_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"spread_domain": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"tenancy": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								ec2.TenancyDedicated,
+								ec2.TenancyDefault,
+								ec2.TenancyHost,
+							}, false),
+						},
+					},
+				},
+			},
+
+			"ram_disk_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"security_group_names": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				Set:           schema.HashString,
+				ConflictsWith: []string{"vpc_security_group_ids"},
+			},
+
+			"vpc_security_group_ids": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				Set:           schema.HashString,
+				ConflictsWith: []string{"security_group_names"},
+			},
+
+			"tag_specifications": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								ec2.ResourceTypeInstance,
+								ec2.ResourceTypeVolume,
+							}, false),
+						},
+						"tags": tagsSchema(),
+					},
+				},
+			},
+
+			"user_data": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateBase64EncodedString,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsLaunchTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	var ltName string
+	if v, ok := d.GetOk("name"); ok {
+		ltName = v.(string)
+	} else if v, ok := d.GetOk("name_prefix"); ok {
+		ltName = resource.PrefixedUniqueId(v.(string))
+	} else {
+		ltName = resource.UniqueId()
+	}
+
+	launchTemplateData, err := expandLaunchTemplateData(d)
+	if err != nil {
+		return err
+	}
+
+	input := &ec2.CreateLaunchTemplateInput{
+		ClientToken:        aws.String(resource.UniqueId()),
+		LaunchTemplateName: aws.String(ltName),
+		LaunchTemplateData: launchTemplateData,
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.VersionDescription = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Launch Template: %s", input)
+	output, err := conn.CreateLaunchTemplate(input)
+	if err != nil {
+		return fmt.Errorf("Error creating Launch Template: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.LaunchTemplate.LaunchTemplateId))
+
+	if err := setTags(conn, d); err != nil {
+		return err
+	}
+
+	return resourceAwsLaunchTemplateRead(d, meta)
+}
+
+func resourceAwsLaunchTemplateRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	log.Printf("[DEBUG] Reading Launch Template: %s", d.Id())
+	output, err := conn.DescribeLaunchTemplates(&ec2.DescribeLaunchTemplatesInput{
+		LaunchTemplateIds: []*string{aws.String(d.Id())},
+	})
+	if err != nil {
+		if isAWSErr(err, "InvalidLaunchTemplateId.NotFound", "") || isAWSErr(err, "InvalidLaunchTemplateId.Malformed", "") {
+			log.Printf("[WARN] Launch Template (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading Launch Template (%s): %s", d.Id(), err)
+	}
+
+	if len(output.LaunchTemplates) == 0 {
+		log.Printf("[WARN] Launch Template (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	lt := output.LaunchTemplates[0]
+
+	// The configuration describes the latest version of the template.
+	version, err := describeLaunchTemplateVersion(conn, d.Id(), strconv.FormatInt(aws.Int64Value(lt.LatestVersionNumber), 10))
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*AWSClient)
+	d.Set("arn", arnString(client.partition, client.region, "ec2", client.accountid, fmt.Sprintf("launch-template/%s", d.Id())))
+	d.Set("name", lt.LaunchTemplateName)
+	d.Set("default_version", lt.DefaultVersionNumber)
+	d.Set("latest_version", lt.LatestVersionNumber)
+	d.Set("description", version.VersionDescription)
+	d.Set("tags", tagsToMap(lt.Tags))
+
+	return setLaunchTemplateData(d, version.LaunchTemplateData)
+}
+
+func resourceAwsLaunchTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	if launchTemplateDataHasChange(d) {
+		launchTemplateData, err := expandLaunchTemplateData(d)
+		if err != nil {
+			return err
+		}
+
+		input := &ec2.CreateLaunchTemplateVersionInput{
+			ClientToken:        aws.String(resource.UniqueId()),
+			LaunchTemplateId:   aws.String(d.Id()),
+			LaunchTemplateData: launchTemplateData,
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			input.VersionDescription = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Creating Launch Template version: %s", input)
+		output, err := conn.CreateLaunchTemplateVersion(input)
+		if err != nil {
+			return fmt.Errorf("Error creating Launch Template (%s) version: %s", d.Id(), err)
+		}
+
+		if d.Get("update_default_version").(bool) {
+			version := strconv.FormatInt(aws.Int64Value(output.LaunchTemplateVersion.VersionNumber), 10)
+
+			log.Printf("[DEBUG] Setting Launch Template (%s) default version to %s", d.Id(), version)
+			_, err := conn.ModifyLaunchTemplate(&ec2.ModifyLaunchTemplateInput{
+				LaunchTemplateId: aws.String(d.Id()),
+				DefaultVersion:   aws.String(version),
+			})
+			if err != nil {
+				return fmt.Errorf("Error setting Launch Template (%s) default version: %s", d.Id(), err)
+			}
+		}
+	}
+
+	if err := setTags(conn, d); err != nil {
+		return err
+	}
+
+	return resourceAwsLaunchTemplateRead(d, meta)
+}
+
+func resourceAwsLaunchTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	log.Printf("[DEBUG] Deleting Launch Template: %s", d.Id())
+	_, err := conn.DeleteLaunchTemplate(&ec2.DeleteLaunchTemplateInput{
+		LaunchTemplateId: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, "InvalidLaunchTemplateId.NotFound", "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Launch Template (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// launchTemplateDataKeys are the attributes stored in each version of a
+// Launch Template, a change to any of which creates a new version.
+var launchTemplateDataKeys = []string{
+	"description",
+	"block_device_mappings",
+	"credit_specification",
+	"disable_api_termination",
+	"ebs_optimized",
+	"elastic_gpu_specifications",
+	"iam_instance_profile",
+	"image_id",
+	"instance_initiated_shutdown_behavior",
+	"instance_market_options",
+	"instance_type",
+	"kernel_id",
+	"key_name",
+	"monitoring",
+	"network_interfaces",
+	"placement",
+	"ram_disk_id",
+	"security_group_names",
+	"vpc_security_group_ids",
+	"tag_specifications",
+	"user_data",
+}
+
+// resourceAwsLaunchTemplateCustomizeDiff marks the version numbers as
+// changing when an update creates a new version, so that references to them
+// see the new version during the same apply.
+func resourceAwsLaunchTemplateCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	for _, key := range launchTemplateDataKeys {
+		if diff.HasChange(key) {
+			if err := diff.SetNewComputed("latest_version"); err != nil {
+				return err
+			}
+			if diff.Get("update_default_version").(bool) {
+				return diff.SetNewComputed("default_version")
+			}
+			return nil
+		}
+	}
+
+	return nil
+}
+
+func launchTemplateDataHasChange(d *schema.ResourceData) bool {
+	for _, key := range launchTemplateDataKeys {
+		if d.HasChange(key) {
+			return true
+		}
+	}
+	return false
+}
+
+func describeLaunchTemplateVersion(conn *ec2.EC2, id, version string) (*ec2.LaunchTemplateVersion, error) {
+	output, err := conn.DescribeLaunchTemplateVersions(&ec2.DescribeLaunchTemplateVersionsInput{
+		LaunchTemplateId: aws.String(id),
+		Versions:         []*string{aws.String(version)},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error reading Launch Template (%s) version %s: %s", id, version, err)
+	}
+
+	if len(output.LaunchTemplateVersions) == 0 {
+		return nil, fmt.Errorf("Launch Template (%s) version %s not found", id, version)
+	}
+
+	return output.LaunchTemplateVersions[0], nil
+}
+
+func expandLaunchTemplateData(d *schema.ResourceData) (*ec2.RequestLaunchTemplateData, error) {
+	opts := &ec2.RequestLaunchTemplateData{}
+
+	if v, ok := d.GetOk("image_id"); ok {
+		opts.ImageId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("instance_initiated_shutdown_behavior"); ok {
+		opts.InstanceInitiatedShutdownBehavior = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("instance_type"); ok {
+		opts.InstanceType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kernel_id"); ok {
+		opts.KernelId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("key_name"); ok {
+		opts.KeyName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("ram_disk_id"); ok {
+		opts.RamDiskId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("user_data"); ok {
+		opts.UserData = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("disable_api_termination"); ok {
+		opts.DisableApiTermination = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("ebs_optimized"); ok {
+		opts.EbsOptimized = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("security_group_names"); ok {
+		opts.SecurityGroups = expandStringList(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("vpc_security_group_ids"); ok {
+		opts.SecurityGroupIds = expandStringList(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("block_device_mappings"); ok {
+		for _, raw := range v.([]interface{}) {
+			opts.BlockDeviceMappings = append(opts.BlockDeviceMappings, expandLaunchTemplateBlockDeviceMapping(raw.(map[string]interface{})))
+		}
+	}
+
+	if v, ok := d.GetOk("credit_specification"); ok {
+		if m, ok := v.([]interface{})[0].(map[string]interface{}); ok {
+			opts.CreditSpecification = &ec2.CreditSpecificationRequest{
+				CpuCredits: aws.String(m["cpu_credits"].(string)),
+			}
+		}
+	}
+
+	if v, ok := d.GetOk("elastic_gpu_specifications"); ok {
+		for _, raw := range v.([]interface{}) {
+			m := raw.(map[string]interface{})
+			opts.ElasticGpuSpecifications = append(opts.ElasticGpuSpecifications, &ec2.ElasticGpuSpecification{
+				Type: aws.String(m["type"].(string)),
+			})
+		}
+	}
+
+	if v, ok := d.GetOk("iam_instance_profile"); ok {
+		if m, ok := v.([]interface{})[0].(map[string]interface{}); ok {
+			profile := &ec2.LaunchTemplateIamInstanceProfileSpecificationRequest{}
+			if v, ok := m["arn"].(string); ok && v != "" {
+				profile.Arn = aws.String(v)
+			}
+			if v, ok := m["name"].(string); ok && v != "" {
+				profile.Name = aws.String(v)
+			}
+			opts.IamInstanceProfile = profile
+		}
+	}
+
+	if v, ok := d.GetOk("instance_market_options"); ok {
+		if m, ok := v.([]interface{})[0].(map[string]interface{}); ok {
+			marketOptions, err := expandLaunchTemplateInstanceMarketOptions(m)
+			if err != nil {
+				return nil, err
+			}
+			opts.InstanceMarketOptions = marketOptions
+		}
+	}
+
+	if v, ok := d.GetOk("monitoring"); ok {
+		if m, ok := v.([]interface{})[0].(map[string]interface{}); ok {
+			opts.Monitoring = &ec2.LaunchTemplatesMonitoringRequest{
+				Enabled: aws.Bool(m["enabled"].(bool)),
+			}
+		}
+	}
+
+	if v, ok := d.GetOk("network_interfaces"); ok {
+		for _, raw := range v.([]interface{}) {
+			opts.NetworkInterfaces = append(opts.NetworkInterfaces, expandLaunchTemplateNetworkInterface(raw.(map[string]interface{})))
+		}
+	}
+
+	if v, ok := d.GetOk("placement"); ok {
+		if m, ok := v.([]interface{})[0].(map[string]interface{}); ok {
+			opts.Placement = expandLaunchTemplatePlacement(m)
+		}
+	}
+
+	if v, ok := d.GetOk("tag_specifications"); ok {
+		for _, raw := range v.([]interface{}) {
+			m := raw.(map[string]interface{})
+			opts.TagSpecifications = append(opts.TagSpecifications, &ec2.LaunchTemplateTagSpecificationRequest{
+				ResourceType: aws.String(m["resource_type"].(string)),
+				Tags:         tagsFromMap(m["tags"].(map[string]interface{})),
+			})
+		}
+	}
+
+	return opts, nil
+}
+
+func expandLaunchTemplateBlockDeviceMapping(m map[string]interface{}) *ec2.LaunchTemplateBlockDeviceMappingRequest {
+	mapping := &ec2.LaunchTemplateBlockDeviceMappingRequest{}
+
+	if v := m["device_name"].(string); v != "" {
+		mapping.DeviceName = aws.String(v)
+	}
+	if v := m["no_device"].(string); v != "" {
+		mapping.NoDevice = aws.String(v)
+	}
+	if v := m["virtual_name"].(string); v != "" {
+		mapping.VirtualName = aws.String(v)
+	}
+
+	if v, ok := m["ebs"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		ebs := v[0].(map[string]interface{})
+		ebsRequest := &ec2.LaunchTemplateEbsBlockDeviceRequest{
+			DeleteOnTermination: aws.Bool(ebs["delete_on_termination"].(bool)),
+		}
+
+		if v := ebs["encrypted"].(bool); v {
+			ebsRequest.Encrypted = aws.Bool(v)
+		}
+		if v := ebs["iops"].(int); v > 0 {
+			ebsRequest.Iops = aws.Int64(int64(v))
+		}
+		if v := ebs["kms_key_id"].(string); v != "" {
+			ebsRequest.KmsKeyId = aws.String(v)
+		}
+		if v := ebs["snapshot_id"].(string); v != "" {
+			ebsRequest.SnapshotId = aws.String(v)
+		}
+		if v := ebs["volume_size"].(int); v > 0 {
+			ebsRequest.VolumeSize = aws.Int64(int64(v))
+		}
+		if v := ebs["volume_type"].(string); v != "" {
+			ebsRequest.VolumeType = aws.String(v)
+		}
+
+		mapping.Ebs = ebsRequest
+	}
+
+	return mapping
+}
+
+func expandLaunchTemplateInstanceMarketOptions(m map[string]interface{}) (*ec2.LaunchTemplateInstanceMarketOptionsRequest, error) {
+	options := &ec2.LaunchTemplateInstanceMarketOptionsRequest{}
+
+	if v := m["market_type"].(string); v != "" {
+		options.MarketType = aws.String(v)
+	}
+
+	if v, ok := m["spot_options"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		so := v[0].(map[string]interface{})
+		spotOptions := &ec2.LaunchTemplateSpotMarketOptionsRequest{}
+
+		if v := so["block_duration_minutes"].(int); v != 0 {
+			spotOptions.BlockDurationMinutes = aws.Int64(int64(v))
+		}
+		if v := so["instance_interruption_behavior"].(string); v != "" {
+			spotOptions.InstanceInterruptionBehavior = aws.String(v)
+		}
+		if v := so["max_price"].(string); v != "" {
+			spotOptions.MaxPrice = aws.String(v)
+		}
+		if v := so["spot_instance_type"].(string); v != "" {
+			spotOptions.SpotInstanceType = aws.String(v)
+		}
+		if v := so["valid_until"].(string); v != "" {
+			t, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				return nil, fmt.Errorf("Error parsing valid_until: %s", err)
+			}
+			spotOptions.ValidUntil = aws.Time(t)
+		}
+
+		options.SpotOptions = spotOptions
+	}
+
+	return options, nil
+}
+
+func expandLaunchTemplateNetworkInterface(m map[string]interface{}) *ec2.LaunchTemplateInstanceNetworkInterfaceSpecificationRequest {
+	ni := &ec2.LaunchTemplateInstanceNetworkInterfaceSpecificationRequest{
+		DeviceIndex: aws.Int64(int64(m["device_index"].(int))),
+	}
+
+	if v := m["associate_public_ip_address"].(bool); v {
+		ni.AssociatePublicIpAddress = aws.Bool(v)
+	}
+	if v := m["delete_on_termination"].(bool); v {
+		ni.DeleteOnTermination = aws.Bool(v)
+	}
+	if v := m["description"].(string); v != "" {
+		ni.Description = aws.String(v)
+	}
+	if v := m["network_interface_id"].(string); v != "" {
+		ni.NetworkInterfaceId = aws.String(v)
+	}
+	if v := m["private_ip_address"].(string); v != "" {
+		ni.PrivateIpAddress = aws.String(v)
+	}
+	if v := m["subnet_id"].(string); v != "" {
+		ni.SubnetId = aws.String(v)
+	}
+	if v := m["security_groups"].(*schema.Set); v.Len() > 0 {
+		ni.Groups = expandStringList(v.List())
+	}
+
+	if v := m["ipv6_address_count"].(int); v > 0 {
+		ni.Ipv6AddressCount = aws.Int64(int64(v))
+	}
+	for _, address := range m["ipv6_addresses"].(*schema.Set).List() {
+		ni.Ipv6Addresses = append(ni.Ipv6Addresses, &ec2.InstanceIpv6AddressRequest{
+			Ipv6Address: aws.String(address.(string)),
+		})
+	}
+
+	if v := m["ipv4_address_count"].(int); v > 0 {
+		ni.SecondaryPrivateIpAddressCount = aws.Int64(int64(v))
+	}
+	for _, address := range m["ipv4_addresses"].(*schema.Set).List() {
+		ni.PrivateIpAddresses = append(ni.PrivateIpAddresses, &ec2.PrivateIpAddressSpecification{
+			Primary:          aws.Bool(false),
+			PrivateIpAddress: aws.String(address.(string)),
+		})
+	}
+
+	return ni
+}
+
+func expandLaunchTemplatePlacement(m map[string]interface{}) *ec2.LaunchTemplatePlacementRequest {
+	placement := &ec2.LaunchTemplatePlacementRequest{}
+
+	if v := m["affinity"].(string); v != "" {
+		placement.Affinity = aws.String(v)
+	}
+	if v := m["availability_zone"].(string); v != "" {
+		placement.AvailabilityZone = aws.String(v)
+	}
+	if v := m["group_name"].(string); v != "" {
+		placement.GroupName = aws.String(v)
+	}
+	if v := m["host_id"].(string); v != "" {
+		placement.HostId = aws.String(v)
+	}
+	if v := m["spread_domain"].(string); v != "" {
+		placement.SpreadDomain = aws.String(v)
+	}
+	if v := m["tenancy"].(string); v != "" {
+		placement.Tenancy = aws.String(v)
+	}
+
+	return placement
+}
+
+// setLaunchTemplateData sets the attributes describing a version of a Launch
+// Template. It is shared by the resource and the data source.
+func setLaunchTemplateData(d *schema.ResourceData, data *ec2.ResponseLaunchTemplateData) error {
+	if data == nil {
+		return nil
+	}
+
+	d.Set("disable_api_termination", data.DisableApiTermination)
+	d.Set("ebs_optimized", data.EbsOptimized)
+	d.Set("image_id", data.ImageId)
+	d.Set("instance_initiated_shutdown_behavior", data.InstanceInitiatedShutdownBehavior)
+	d.Set("instance_type", data.InstanceType)
+	d.Set("kernel_id", data.KernelId)
+	d.Set("key_name", data.KeyName)
+	d.Set("ram_disk_id", data.RamDiskId)
+	d.Set("user_data", data.UserData)
+
+	if err := d.Set("security_group_names", aws.StringValueSlice(data.SecurityGroups)); err != nil {
+		return fmt.Errorf("Error setting security_group_names: %s", err)
+	}
+	if err := d.Set("vpc_security_group_ids", aws.StringValueSlice(data.SecurityGroupIds)); err != nil {
+		return fmt.Errorf("Error setting vpc_security_group_ids: %s", err)
+	}
+	if err := d.Set("block_device_mappings", flattenLaunchTemplateBlockDeviceMappings(data.BlockDeviceMappings)); err != nil {
+		return fmt.Errorf("Error setting block_device_mappings: %s", err)
+	}
+	if err := d.Set("credit_specification", flattenLaunchTemplateCreditSpecification(data.CreditSpecification)); err != nil {
+		return fmt.Errorf("Error setting credit_specification: %s", err)
+	}
+	if err := d.Set("elastic_gpu_specifications", flattenLaunchTemplateElasticGpuSpecifications(data.ElasticGpuSpecifications)); err != nil {
+		return fmt.Errorf("Error setting elastic_gpu_specifications: %s", err)
+	}
+	if err := d.Set("iam_instance_profile", flattenLaunchTemplateIamInstanceProfile(data.IamInstanceProfile)); err != nil {
+		return fmt.Errorf("Error setting iam_instance_profile: %s", err)
+	}
+	if err := d.Set("instance_market_options", flattenLaunchTemplateInstanceMarketOptions(data.InstanceMarketOptions)); err != nil {
+		return fmt.Errorf("Error setting instance_market_options: %s", err)
+	}
+	if err := d.Set("monitoring", flattenLaunchTemplateMonitoring(data.Monitoring)); err != nil {
+		return fmt.Errorf("Error setting monitoring: %s", err)
+	}
+	if err := d.Set("network_interfaces", flattenLaunchTemplateNetworkInterfaces(data.NetworkInterfaces)); err != nil {
+		return fmt.Errorf("Error setting network_interfaces: %s", err)
+	}
+	if err := d.Set("placement", flattenLaunchTemplatePlacement(data.Placement)); err != nil {
+		return fmt.Errorf("Error setting placement: %s", err)
+	}
+	if err := d.Set("tag_specifications", flattenLaunchTemplateTagSpecifications(data.TagSpecifications)); err != nil {
+		return fmt.Errorf("Error setting tag_specifications: %s", err)
+	}
+
+	return nil
+}
+
+func flattenLaunchTemplateBlockDeviceMappings(mappings []*ec2.LaunchTemplateBlockDeviceMapping) []interface{} {
+	result := make([]interface{}, 0, len(mappings))
+	for _, mapping := range mappings {
+		m := map[string]interface{}{
+			"device_name":  aws.StringValue(mapping.DeviceName),
+			"no_device":    aws.StringValue(mapping.NoDevice),
+			"virtual_name": aws.StringValue(mapping.VirtualName),
+		}
+		if ebs := mapping.Ebs; ebs != nil {
+			m["ebs"] = []interface{}{
+				map[string]interface{}{
+					"delete_on_termination": aws.BoolValue(ebs.DeleteOnTermination),
+					"encrypted":             aws.BoolValue(ebs.Encrypted),
+					"iops":                  int(aws.Int64Value(ebs.Iops)),
+					"kms_key_id":            aws.StringValue(ebs.KmsKeyId),
+					"snapshot_id":           aws.StringValue(ebs.SnapshotId),
+					"volume_size":           int(aws.Int64Value(ebs.VolumeSize)),
+					"volume_type":           aws.StringValue(ebs.VolumeType),
+				},
+			}
+		}
+		result = append(result, m)
+	}
+	return result
+}
+
+func flattenLaunchTemplateCreditSpecification(cs *ec2.CreditSpecification) []interface{} {
+	if cs == nil {
+		return []interface{}{}
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"cpu_credits": aws.StringValue(cs.CpuCredits),
+		},
+	}
+}
+
+func flattenLaunchTemplateElasticGpuSpecifications(specs []*ec2.ElasticGpuSpecificationResponse) []interface{} {
+	result := make([]interface{}, 0, len(specs))
+	for _, spec := range specs {
+		result = append(result, map[string]interface{}{
+			"type": aws.StringValue(spec.Type),
+		})
+	}
+	return result
+}
+
+func flattenLaunchTemplateIamInstanceProfile(profile *ec2.LaunchTemplateIamInstanceProfileSpecification) []interface{} {
+	if profile == nil {
+		return []interface{}{}
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"arn":  aws.StringValue(profile.Arn),
+			"name": aws.StringValue(profile.Name),
+		},
+	}
+}
+
+func flattenLaunchTemplateInstanceMarketOptions(options *ec2.LaunchTemplateInstanceMarketOptions) []interface{} {
+	if options == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"market_type": aws.StringValue(options.MarketType),
+	}
+
+	if so := options.SpotOptions; so != nil {
+		var validUntil string
+		if so.ValidUntil != nil {
+			validUntil = aws.TimeValue(so.ValidUntil).Format(time.RFC3339)
+		}
+
+		m["spot_options"] = []interface{}{
+			map[string]interface{}{
+				"block_duration_minutes":         int(aws.Int64Value(so.BlockDurationMinutes)),
+				"instance_interruption_behavior": aws.StringValue(so.InstanceInterruptionBehavior),
+				"max_price":                      aws.StringValue(so.MaxPrice),
+				"spot_instance_type":             aws.StringValue(so.SpotInstanceType),
+				"valid_until":                    validUntil,
+			},
+		}
+	}
+
+	return []interface{}{m}
+}
+
+func flattenLaunchTemplateMonitoring(monitoring *ec2.LaunchTemplatesMonitoring) []interface{} {
+	if monitoring == nil {
+		return []interface{}{}
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"enabled": aws.BoolValue(monitoring.Enabled),
+		},
+	}
+}
+
+func flattenLaunchTemplateNetworkInterfaces(interfaces []*ec2.LaunchTemplateInstanceNetworkInterfaceSpecification) []interface{} {
+	result := make([]interface{}, 0, len(interfaces))
+	for _, ni := range interfaces {
+		ipv6Addresses := make([]string, 0, len(ni.Ipv6Addresses))
+		for _, address := range ni.Ipv6Addresses {
+			ipv6Addresses = append(ipv6Addresses, aws.StringValue(address.Ipv6Address))
+		}
+
+		ipv4Addresses := make([]string, 0, len(ni.PrivateIpAddresses))
+		for _, address := range ni.PrivateIpAddresses {
+			ipv4Addresses = append(ipv4Addresses, aws.StringValue(address.PrivateIpAddress))
+		}
+
+		result = append(result, map[string]interface{}{
+			"associate_public_ip_address": aws.BoolValue(ni.AssociatePublicIpAddress),
+			"delete_on_termination":       aws.BoolValue(ni.DeleteOnTermination),
+			"description":                 aws.StringValue(ni.Description),
+			"device_index":                int(aws.Int64Value(ni.DeviceIndex)),
+			"security_groups":             schema.NewSet(schema.HashString, flattenStringList(ni.Groups)),
+			"ipv6_address_count":          int(aws.Int64Value(ni.Ipv6AddressCount)),
+			"ipv6_addresses":              schema.NewSet(schema.HashString, interfaceSliceFromStrings(ipv6Addresses)),
+			"network_interface_id":        aws.StringValue(ni.NetworkInterfaceId),
+			"private_ip_address":          aws.StringValue(ni.PrivateIpAddress),
+			"ipv4_addresses":              schema.NewSet(schema.HashString, interfaceSliceFromStrings(ipv4Addresses)),
+			"ipv4_address_count":          int(aws.Int64Value(ni.SecondaryPrivateIpAddressCount)),
+			"subnet_id":                   aws.StringValue(ni.SubnetId),
+		})
+	}
+	return result
+}
+
+func flattenLaunchTemplatePlacement(placement *ec2.LaunchTemplatePlacement) []interface{} {
+	if placement == nil {
+		return []interface{}{}
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"affinity":          aws.StringValue(placement.Affinity),
+			"availability_zone": aws.StringValue(placement.AvailabilityZone),
+			"group_name":        aws.StringValue(placement.GroupName),
+			"host_id":           aws.StringValue(placement.HostId),
+			"spread_domain":     aws.StringValue(placement.SpreadDomain),
+			"tenancy":           aws.StringValue(placement.Tenancy),
+		},
+	}
+}
+
+func flattenLaunchTemplateTagSpecifications(specs []*ec2.LaunchTemplateTagSpecification) []interface{} {
+	result := make([]interface{}, 0, len(specs))
+	for _, spec := range specs {
+		result = append(result, map[string]interface{}{
+			"resource_type": aws.StringValue(spec.ResourceType),
+			"tags":          tagsToMap(spec.Tags),
+		})
+	}
+	return result
+}
+
+func interfaceSliceFromStrings(s []string) []interface{} {
+	result := make([]interface{}, 0, len(s))
+	for _, v := range s {
+		result = append(result, v)
+	}
+	return result
+}
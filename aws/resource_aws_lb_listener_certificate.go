@@ -0,0 +1,182 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsLbListenerCertificate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLbListenerCertificateCreate,
+		Read:   resourceAwsLbListenerCertificateRead,
+		Delete: resourceAwsLbListenerCertificateDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"listener_arn": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"certificate_arn": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsLbListenerCertificateCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).elbv2conn
+
+	listenerArn := d.Get("listener_arn").(string)
+	certificateArn := d.Get("certificate_arn").(string)
+
+	// AddListenerCertificates succeeds if the certificate is already
+	// attached, so adopting an existing attachment needs no special casing.
+	params := &elbv2.AddListenerCertificatesInput{
+		ListenerArn: aws.String(listenerArn),
+		Certificates: []*elbv2.Certificate{
+			{
+				CertificateArn: aws.String(certificateArn),
+			},
+		},
+	}
+
+	log.Printf("[DEBUG] Adding certificate: %s to listener: %s", certificateArn, listenerArn)
+
+	// Newly issued certificates may not be visible to the load balancer yet.
+	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+		_, err := conn.AddListenerCertificates(params)
+		if err != nil {
+			if isAWSErr(err, elbv2.ErrCodeCertificateNotFoundException, "") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return errwrap.Wrapf("Error creating LB Listener Certificate: {{err}}", err)
+	}
+
+	d.SetId(lbListenerCertificateId(listenerArn, certificateArn))
+
+	return resourceAwsLbListenerCertificateRead(d, meta)
+}
+
+func resourceAwsLbListenerCertificateRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).elbv2conn
+
+	listenerArn, certificateArn, err := lbListenerCertificateParseId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Reading certificate: %s of listener: %s", certificateArn, listenerArn)
+
+	found, err := findAwsLbListenerCertificate(conn, listenerArn, certificateArn)
+	if err != nil {
+		if isAWSErr(err, elbv2.ErrCodeListenerNotFoundException, "") {
+			log.Printf("[WARN] LB Listener (%s) not found, removing LB Listener Certificate (%s) from state", listenerArn, d.Id())
+			d.SetId("")
+			return nil
+		}
+		return errwrap.Wrapf("Error reading LB Listener Certificate: {{err}}", err)
+	}
+	if !found {
+		log.Printf("[WARN] LB Listener Certificate (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("listener_arn", listenerArn)
+	d.Set("certificate_arn", certificateArn)
+
+	return nil
+}
+
+func resourceAwsLbListenerCertificateDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).elbv2conn
+
+	listenerArn, certificateArn, err := lbListenerCertificateParseId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting certificate: %s from listener: %s", certificateArn, listenerArn)
+
+	_, err = conn.RemoveListenerCertificates(&elbv2.RemoveListenerCertificatesInput{
+		ListenerArn: aws.String(listenerArn),
+		Certificates: []*elbv2.Certificate{
+			{
+				CertificateArn: aws.String(certificateArn),
+			},
+		},
+	})
+	if err != nil {
+		if isAWSErr(err, elbv2.ErrCodeCertificateNotFoundException, "") {
+			return nil
+		}
+		if isAWSErr(err, elbv2.ErrCodeListenerNotFoundException, "") {
+			return nil
+		}
+		return errwrap.Wrapf("Error removing LB Listener Certificate: {{err}}", err)
+	}
+
+	return nil
+}
+
+func findAwsLbListenerCertificate(conn *elbv2.ELBV2, listenerArn, certificateArn string) (bool, error) {
+	params := &elbv2.DescribeListenerCertificatesInput{
+		ListenerArn: aws.String(listenerArn),
+		PageSize:    aws.Int64(400),
+	}
+
+	for {
+		resp, err := conn.DescribeListenerCertificates(params)
+		if err != nil {
+			return false, err
+		}
+
+		for _, cert := range resp.Certificates {
+			// The default certificate of the listener is managed by aws_lb_listener.
+			if aws.BoolValue(cert.IsDefault) {
+				continue
+			}
+			if aws.StringValue(cert.CertificateArn) == certificateArn {
+				return true, nil
+			}
+		}
+
+		if resp.NextMarker == nil {
+			return false, nil
+		}
+		params.Marker = resp.NextMarker
+	}
+}
+
+// Listener ARNs never contain underscores, so the first one separates the
+// listener from the certificate.
+func lbListenerCertificateId(listenerArn, certificateArn string) string {
+	return listenerArn + "_" + certificateArn
+}
+
+func lbListenerCertificateParseId(id string) (string, string, error) {
+	parts := strings.SplitN(id, "_", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("Unexpected format of ID (%q), expected LISTENER-ARN_CERTIFICATE-ARN", id)
+	}
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,199 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestLbListenerCertificateParseId(t *testing.T) {
+	listenerArn := "arn:aws:elasticloadbalancing:us-east-1:123456789012:listener/app/my-lb/50dc6c495c0c9188/f2f7dc8efc522ab2"
+	certificateArn := "arn:aws:iam::123456789012:server-certificate/my_cert"
+
+	id := lbListenerCertificateId(listenerArn, certificateArn)
+	actualListenerArn, actualCertificateArn, err := lbListenerCertificateParseId(id)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if actualListenerArn != listenerArn {
+		t.Fatalf("Expected listener ARN %q, got %q", listenerArn, actualListenerArn)
+	}
+	if actualCertificateArn != certificateArn {
+		t.Fatalf("Expected certificate ARN %q, got %q", certificateArn, actualCertificateArn)
+	}
+
+	for _, id := range []string{"", listenerArn, listenerArn + "_", "_" + certificateArn} {
+		if _, _, err := lbListenerCertificateParseId(id); err == nil {
+			t.Fatalf("Expected an error for ID %q", id)
+		}
+	}
+}
+
+func TestAccAwsLbListenerCertificate_basic(t *testing.T) {
+	rName := acctest.RandStringFromCharSet(8, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProvidersWithTLS,
+		CheckDestroy: testAccCheckAwsLbListenerCertificateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLbListenerCertificateConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsLbListenerCertificateExists("aws_lb_listener_certificate.test"),
+					resource.TestCheckResourceAttrPair("aws_lb_listener_certificate.test", "listener_arn", "aws_lb_listener.test", "arn"),
+					resource.TestCheckResourceAttrPair("aws_lb_listener_certificate.test", "certificate_arn", "aws_iam_server_certificate.test.1", "arn"),
+				),
+			},
+			{
+				ResourceName:      "aws_lb_listener_certificate.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsLbListenerCertificateDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).elbv2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lb_listener_certificate" {
+			continue
+		}
+
+		listenerArn, certificateArn, err := lbListenerCertificateParseId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		found, err := findAwsLbListenerCertificate(conn, listenerArn, certificateArn)
+		if err != nil {
+			if isAWSErr(err, elbv2.ErrCodeListenerNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+		if found {
+			return fmt.Errorf("LB Listener Certificate %q still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsLbListenerCertificateExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		listenerArn, certificateArn, err := lbListenerCertificateParseId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).elbv2conn
+		found, err := findAwsLbListenerCertificate(conn, listenerArn, certificateArn)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return fmt.Errorf("LB Listener Certificate %q not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccLbListenerCertificateConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_lb_listener_certificate" "test" {
+  listener_arn    = "${aws_lb_listener.test.arn}"
+  certificate_arn = "${aws_iam_server_certificate.test.1.arn}"
+}
+
+resource "aws_lb_listener" "test" {
+  load_balancer_arn = "${aws_lb.test.arn}"
+  protocol          = "HTTPS"
+  port              = "443"
+  ssl_policy        = "ELBSecurityPolicy-2016-08"
+  certificate_arn   = "${aws_iam_server_certificate.test.0.arn}"
+
+  default_action {
+    target_group_arn = "${aws_lb_target_group.test.arn}"
+    type             = "forward"
+  }
+}
+
+resource "aws_lb" "test" {
+  name     = "tf-lb-cert-%[1]s"
+  internal = true
+  subnets  = ["${aws_subnet.test.*.id}"]
+}
+
+resource "aws_lb_target_group" "test" {
+  name     = "tf-lb-cert-%[1]s"
+  port     = 443
+  protocol = "HTTP"
+  vpc_id   = "${aws_vpc.test.id}"
+}
+
+data "aws_availability_zones" "available" {}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags {
+    Name = "terraform-testacc-lb-listener-certificate"
+  }
+}
+
+resource "aws_subnet" "test" {
+  count             = 2
+  vpc_id            = "${aws_vpc.test.id}"
+  cidr_block        = "10.0.${count.index}.0/24"
+  availability_zone = "${element(data.aws_availability_zones.available.names, count.index)}"
+
+  tags {
+    Name = "terraform-testacc-lb-listener-certificate"
+  }
+}
+
+resource "aws_iam_server_certificate" "test" {
+  count            = 2
+  name             = "tf-lb-cert-%[1]s-${count.index}"
+  certificate_body = "${element(tls_self_signed_cert.test.*.cert_pem, count.index)}"
+  private_key      = "${element(tls_private_key.test.*.private_key_pem, count.index)}"
+}
+
+resource "tls_private_key" "test" {
+  count     = 2
+  algorithm = "RSA"
+}
+
+resource "tls_self_signed_cert" "test" {
+  count           = 2
+  key_algorithm   = "RSA"
+  private_key_pem = "${element(tls_private_key.test.*.private_key_pem, count.index)}"
+
+  subject {
+    common_name  = "example${count.index}.com"
+    organization = "ACME Examples, Inc"
+  }
+
+  validity_period_hours = 12
+
+  allowed_uses = [
+    "key_encipherment",
+    "digital_signature",
+    "server_auth",
+  ]
+}
+`, rName)
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/errwrap"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func resourceAwsLbTargetGroup() *schema.Resource {
@@ -82,11 +83,22 @@ func resourceAwsLbTargetGroup() *schema.Resource {
 				ValidateFunc: validateAwsLbTargetGroupDeregistrationDelay,
 			},
 
+			"slow_start": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validateAwsLbTargetGroupSlowStart,
+			},
+
 			"target_type": {
 				Type:     schema.TypeString,
 				Optional: true,
-				Default:  "instance",
+				Default:  elbv2.TargetTypeEnumInstance,
 				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					elbv2.TargetTypeEnumInstance,
+					elbv2.TargetTypeEnumIp,
+				}, false),
 			},
 
 			"stickiness": {
@@ -314,6 +326,13 @@ func resourceAwsLbTargetGroupUpdate(d *schema.ResourceData, meta interface{}) er
 		})
 	}
 
+	if d.HasChange("slow_start") {
+		attrs = append(attrs, &elbv2.TargetGroupAttribute{
+			Key:   aws.String("slow_start.duration_seconds"),
+			Value: aws.String(fmt.Sprintf("%d", d.Get("slow_start").(int))),
+		})
+	}
+
 	if d.HasChange("stickiness") {
 		stickinessBlocks := d.Get("stickiness").([]interface{})
 		if len(stickinessBlocks) == 1 {
@@ -453,6 +472,19 @@ func validateAwsLbTargetGroupDeregistrationDelay(v interface{}, k string) (ws []
 	return
 }
 
+func validateAwsLbTargetGroupSlowStart(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(int)
+
+	// Check if the value is between 30-900 or 0 (seconds).
+	if value != 0 && !(value >= 30 && value <= 900) {
+		errors = append(errors, fmt.Errorf(
+			"%q contains an invalid Slow Start Duration \"%d\". "+
+				"Valid intervals are 30-900 or 0 to disable.",
+			k, value))
+	}
+	return
+}
+
 func validateAwsLbTargetGroupStickinessType(v interface{}, k string) (ws []string, errors []error) {
 	stickinessType := v.(string)
 	if stickinessType != "lb_cookie" {
@@ -544,6 +576,12 @@ func flattenAwsLbTargetGroupResource(d *schema.ResourceData, meta interface{}, t
 				return fmt.Errorf("Error converting deregistration_delay.timeout_seconds to int: %s", *attr.Value)
 			}
 			d.Set("deregistration_delay", timeout)
+		case "slow_start.duration_seconds":
+			slowStart, err := strconv.Atoi(*attr.Value)
+			if err != nil {
+				return fmt.Errorf("Error converting slow_start.duration_seconds to int: %s", *attr.Value)
+			}
+			d.Set("slow_start", slowStart)
 		}
 	}
 
@@ -65,6 +65,8 @@ func TestAccAWSLBTargetGroup_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("aws_lb_target_group.test", "protocol", "HTTPS"),
 					resource.TestCheckResourceAttrSet("aws_lb_target_group.test", "vpc_id"),
 					resource.TestCheckResourceAttr("aws_lb_target_group.test", "deregistration_delay", "200"),
+					resource.TestCheckResourceAttr("aws_lb_target_group.test", "slow_start", "0"),
+					resource.TestCheckResourceAttr("aws_lb_target_group.test", "target_type", "instance"),
 					resource.TestCheckResourceAttr("aws_lb_target_group.test", "stickiness.#", "1"),
 					resource.TestCheckResourceAttr("aws_lb_target_group.test", "stickiness.0.enabled", "true"),
 					resource.TestCheckResourceAttr("aws_lb_target_group.test", "stickiness.0.type", "lb_cookie"),
@@ -86,6 +88,55 @@ func TestAccAWSLBTargetGroup_basic(t *testing.T) {
 	})
 }
 
+func TestLBTargetGroupSlowStart(t *testing.T) {
+	for _, v := range []int{0, 30, 300, 900} {
+		if _, errors := validateAwsLbTargetGroupSlowStart(v, "slow_start"); len(errors) != 0 {
+			t.Fatalf("%d should be a valid slow start duration: %q", v, errors)
+		}
+	}
+
+	for _, v := range []int{-1, 1, 29, 901} {
+		if _, errors := validateAwsLbTargetGroupSlowStart(v, "slow_start"); len(errors) == 0 {
+			t.Fatalf("%d should be an invalid slow start duration", v)
+		}
+	}
+}
+
+func TestAccAWSLBTargetGroup_updateSlowStart(t *testing.T) {
+	var conf elbv2.TargetGroup
+	targetGroupName := fmt.Sprintf("test-target-group-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "aws_lb_target_group.test",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckAWSLBTargetGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLBTargetGroupConfig_updateSlowStart(targetGroupName, 30),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSLBTargetGroupExists("aws_lb_target_group.test", &conf),
+					resource.TestCheckResourceAttr("aws_lb_target_group.test", "slow_start", "30"),
+				),
+			},
+			{
+				Config: testAccAWSLBTargetGroupConfig_updateSlowStart(targetGroupName, 60),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSLBTargetGroupExists("aws_lb_target_group.test", &conf),
+					resource.TestCheckResourceAttr("aws_lb_target_group.test", "slow_start", "60"),
+				),
+			},
+			{
+				Config: testAccAWSLBTargetGroupConfig_updateSlowStart(targetGroupName, 0),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSLBTargetGroupExists("aws_lb_target_group.test", &conf),
+					resource.TestCheckResourceAttr("aws_lb_target_group.test", "slow_start", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSLBTargetGroup_networkLB_TargetGroup(t *testing.T) {
 	var confBefore, confAfter elbv2.TargetGroup
 	targetGroupName := fmt.Sprintf("test-target-group-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
@@ -687,6 +738,25 @@ resource "aws_vpc" "test" {
 }`, targetGroupName)
 }
 
+func testAccAWSLBTargetGroupConfig_updateSlowStart(targetGroupName string, slowStartDuration int) string {
+	return fmt.Sprintf(`resource "aws_lb_target_group" "test" {
+  name = "%s"
+  port = 443
+  protocol = "HTTP"
+  vpc_id = "${aws_vpc.test.id}"
+
+  slow_start = %d
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags {
+    TestName = "TestAccAWSLBTargetGroup_updateSlowStart"
+  }
+}`, targetGroupName, slowStartDuration)
+}
+
 func testAccAWSLBTargetGroupConfigBackwardsCompatibility(targetGroupName string) string {
 	return fmt.Sprintf(`resource "aws_alb_target_group" "test" {
   name = "%s"
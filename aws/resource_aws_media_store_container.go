@@ -2,13 +2,15 @@ package aws
 
 import (
 	"fmt"
+	"log"
 	"regexp"
-	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/mediastore"
-	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/mediastore/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/mediastore/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
 )
 
 func resourceAwsMediaStoreContainer() *schema.Resource {
@@ -53,16 +55,8 @@ func resourceAwsMediaStoreContainerCreate(d *schema.ResourceData, meta interface
 	if err != nil {
 		return err
 	}
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{mediastore.ContainerStatusCreating},
-		Target:     []string{mediastore.ContainerStatusActive},
-		Refresh:    mediaStoreContainerRefreshStatusFunc(conn, d.Get("name").(string)),
-		Timeout:    10 * time.Minute,
-		Delay:      10 * time.Second,
-		MinTimeout: 3 * time.Second,
-	}
 
-	_, err = stateConf.WaitForState()
+	_, err = waiter.ContainerActive(conn, d.Get("name").(string))
 	if err != nil {
 		return err
 	}
@@ -74,15 +68,17 @@ func resourceAwsMediaStoreContainerCreate(d *schema.ResourceData, meta interface
 func resourceAwsMediaStoreContainerRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).mediastoreconn
 
-	input := &mediastore.DescribeContainerInput{
-		ContainerName: aws.String(d.Id()),
+	container, err := finder.ContainerByName(conn, d.Id())
+	if tfresource.NotFound(err) {
+		log.Printf("[WARN] Media Store Container (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
 	}
-	resp, err := conn.DescribeContainer(input)
 	if err != nil {
 		return err
 	}
-	d.Set("arn", resp.Container.ARN)
-	d.Set("endpoint", resp.Container.Endpoint)
+	d.Set("arn", container.ARN)
+	d.Set("endpoint", container.Endpoint)
 	return nil
 }
 
@@ -101,19 +97,7 @@ func resourceAwsMediaStoreContainerDelete(d *schema.ResourceData, meta interface
 		return err
 	}
 
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
-		dcinput := &mediastore.DescribeContainerInput{
-			ContainerName: aws.String(d.Id()),
-		}
-		_, err := conn.DescribeContainer(dcinput)
-		if err != nil {
-			if isAWSErr(err, mediastore.ErrCodeContainerNotFoundException, "") {
-				return nil
-			}
-			return resource.NonRetryableError(err)
-		}
-		return resource.RetryableError(nil)
-	})
+	_, err = waiter.ContainerDeleted(conn, d.Id())
 	if err != nil {
 		return err
 	}
@@ -121,16 +105,3 @@ func resourceAwsMediaStoreContainerDelete(d *schema.ResourceData, meta interface
 	d.SetId("")
 	return nil
 }
-
-func mediaStoreContainerRefreshStatusFunc(conn *mediastore.MediaStore, cn string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		input := &mediastore.DescribeContainerInput{
-			ContainerName: aws.String(cn),
-		}
-		resp, err := conn.DescribeContainer(input)
-		if err != nil {
-			return nil, "failed", err
-		}
-		return resp, *resp.Container.Status, nil
-	}
-}
@@ -12,7 +12,7 @@ import (
 )
 
 func TestAccAwsMediaStoreContainer_basic(t *testing.T) {
-	resource.Test(t, resource.TestCase{
+	testAccErrorCheckTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckAwsMediaStoreContainerDestroy,
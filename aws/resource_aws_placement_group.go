@@ -92,8 +92,18 @@ func resourceAwsPlacementGroupRead(d *schema.ResourceData, meta interface{}) err
 	}
 	out, err := conn.DescribePlacementGroups(&input)
 	if err != nil {
+		if isAWSErr(err, "InvalidPlacementGroup.Unknown", "") {
+			log.Printf("[WARN] EC2 Placement Group (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
 		return err
 	}
+	if len(out.PlacementGroups) == 0 {
+		log.Printf("[WARN] EC2 Placement Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
 	pg := out.PlacementGroups[0]
 
 	log.Printf("[DEBUG] Received EC2 Placement Group: %s", pg)
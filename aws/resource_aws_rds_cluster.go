@@ -24,6 +24,8 @@ func resourceAwsRDSCluster() *schema.Resource {
 			State: resourceAwsRdsClusterImport,
 		},
 
+		CustomizeDiff: customizeDiffKmsKeyId("kms_key_id"),
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(120 * time.Minute),
 			Update: schema.DefaultTimeout(120 * time.Minute),
@@ -224,7 +226,7 @@ func resourceAwsRDSCluster() *schema.Resource {
 				Optional:     true,
 				Computed:     true,
 				ForceNew:     true,
-				ValidateFunc: validateArn,
+				ValidateFunc: validateKmsKey,
 			},
 
 			"replication_source_identifier": {
@@ -586,7 +588,7 @@ func flattenAwsRdsClusterResource(d *schema.ResourceData, meta interface{}, dbc
 	d.Set("backup_retention_period", dbc.BackupRetentionPeriod)
 	d.Set("preferred_backup_window", dbc.PreferredBackupWindow)
 	d.Set("preferred_maintenance_window", dbc.PreferredMaintenanceWindow)
-	d.Set("kms_key_id", dbc.KmsKeyId)
+	d.Set("kms_key_id", kmsKeyIdStateValue(d.Get("kms_key_id").(string), aws.StringValue(dbc.KmsKeyId)))
 	d.Set("reader_endpoint", dbc.ReaderEndpoint)
 	d.Set("replication_source_identifier", dbc.ReplicationSourceIdentifier)
 	d.Set("iam_database_authentication_enabled", dbc.IAMDatabaseAuthenticationEnabled)
@@ -126,6 +126,11 @@ func resourceAwsRedshiftSecurityGroupCreate(d *schema.ResourceData, meta interfa
 
 func resourceAwsRedshiftSecurityGroupRead(d *schema.ResourceData, meta interface{}) error {
 	sg, err := resourceAwsRedshiftSecurityGroupRetrieve(d, meta)
+	if isAWSErr(err, redshift.ErrCodeClusterSecurityGroupNotFoundFault, "") {
+		log.Printf("[WARN] Redshift Security Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
 	if err != nil {
 		return err
 	}
@@ -237,6 +242,9 @@ func resourceAwsRedshiftSecurityGroupRetrieve(d *schema.ResourceData, meta inter
 	resp, err := conn.DescribeClusterSecurityGroups(&opts)
 
 	if err != nil {
+		if isAWSErr(err, redshift.ErrCodeClusterSecurityGroupNotFoundFault, "") {
+			return nil, err
+		}
 		return nil, fmt.Errorf("Error retrieving Redshift Security Groups: %s", err)
 	}
 
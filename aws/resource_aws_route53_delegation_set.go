@@ -72,6 +72,11 @@ func resourceAwsRoute53DelegationSetRead(d *schema.ResourceData, meta interface{
 	log.Printf("[DEBUG] Reading Route53 reusable delegation set: %#v", input)
 	out, err := r53.GetReusableDelegationSet(input)
 	if err != nil {
+		if isAWSErr(err, route53.ErrCodeNoSuchDelegationSet, "") {
+			log.Printf("[WARN] Route53 reusable delegation set (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
 		return err
 	}
 	log.Printf("[DEBUG] Route53 reusable delegation set received: %#v", out)
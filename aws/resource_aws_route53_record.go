@@ -333,7 +333,9 @@ func resourceAwsRoute53RecordUpdate(d *schema.ResourceData, meta interface{}) er
 	log.Printf("[DEBUG] Updating resource records for zone: %s, name: %s\n\n%s",
 		zone, *rec.Name, req)
 
+	unlock := lockMutation(meta, awsMutexRoute53HostedZoneKey(zone))
 	respRaw, err := changeRoute53RecordSet(conn, req)
+	unlock()
 	if err != nil {
 		return errwrap.Wrapf("[ERR]: Error building changeset: {{err}}", err)
 	}
@@ -400,7 +402,9 @@ func resourceAwsRoute53RecordCreate(d *schema.ResourceData, meta interface{}) er
 	log.Printf("[DEBUG] Creating resource records for zone: %s, name: %s\n\n%s",
 		zone, *rec.Name, req)
 
+	unlock := lockMutation(meta, awsMutexRoute53HostedZoneKey(zone))
 	respRaw, err := changeRoute53RecordSet(conn, req)
+	unlock()
 	if err != nil {
 		return errwrap.Wrapf("[ERR]: Error building changeset: {{err}}", err)
 	}
@@ -667,7 +671,9 @@ func resourceAwsRoute53RecordDelete(d *schema.ResourceData, meta interface{}) er
 		ChangeBatch:  changeBatch,
 	}
 
+	unlock := lockMutation(meta, awsMutexRoute53HostedZoneKey(zone))
 	respRaw, err := deleteRoute53RecordSet(conn, req)
+	unlock()
 	if err != nil {
 		return errwrap.Wrapf("[ERR]: Error building changeset: {{err}}", err)
 	}
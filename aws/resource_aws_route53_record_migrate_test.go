@@ -108,3 +108,34 @@ func TestAWSRoute53RecordMigrateStateV1toV2(t *testing.T) {
 		}
 	}
 }
+
+func TestAWSRoute53RecordMigrateStateV0toV2(t *testing.T) {
+	testStateMigration(t, resourceAwsRoute53RecordMigrateState, map[string]stateMigrationTestCase{
+		"weighted": {
+			StateVersion: 0,
+			ID:           "Z1ABCDEFGHIJKL_www.example.com_A_dev",
+			Attributes: map[string]string{
+				"name":           "www.example.com.",
+				"set_identifier": "dev",
+				"weight":         "10",
+			},
+			Expected: map[string]string{
+				"name":                             "www.example.com",
+				"set_identifier":                   "dev",
+				"weighted_routing_policy.#":        "1",
+				"weighted_routing_policy.0.weight": "10",
+			},
+		},
+		"simple": {
+			StateVersion: 0,
+			ID:           "Z1ABCDEFGHIJKL_www.example.com_A",
+			Attributes: map[string]string{
+				"name":   "www.example.com",
+				"weight": "-1",
+			},
+			Expected: map[string]string{
+				"name": "www.example.com",
+			},
+		},
+	})
+}
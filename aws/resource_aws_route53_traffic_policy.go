@@ -0,0 +1,217 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsRoute53TrafficPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsRoute53TrafficPolicyCreate,
+		Read:   resourceAwsRoute53TrafficPolicyRead,
+		Update: resourceAwsRoute53TrafficPolicyUpdate,
+		Delete: resourceAwsRoute53TrafficPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: resourceAwsRoute53TrafficPolicyCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"comment": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"document": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validateJsonString,
+				DiffSuppressFunc: suppressEquivalentJsonDiffs,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 512),
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsRoute53TrafficPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).r53conn
+
+	input := &route53.CreateTrafficPolicyInput{
+		Document: aws.String(d.Get("document").(string)),
+		Name:     aws.String(d.Get("name").(string)),
+	}
+	if v, ok := d.GetOk("comment"); ok {
+		input.Comment = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Route53 Traffic Policy: %s", input)
+	out, err := conn.CreateTrafficPolicy(input)
+	if err != nil {
+		return fmt.Errorf("Error creating Route53 Traffic Policy: %s", err)
+	}
+
+	d.SetId(aws.StringValue(out.TrafficPolicy.Id))
+
+	return resourceAwsRoute53TrafficPolicyRead(d, meta)
+}
+
+func resourceAwsRoute53TrafficPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).r53conn
+
+	policy, err := readRoute53TrafficPolicyLatestVersion(conn, d.Id())
+	if err != nil {
+		if isAWSErr(err, route53.ErrCodeNoSuchTrafficPolicy, "") {
+			log.Printf("[WARN] Route53 Traffic Policy (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading Route53 Traffic Policy (%s): %s", d.Id(), err)
+	}
+	if policy == nil {
+		log.Printf("[WARN] Route53 Traffic Policy (%s) has no versions, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("comment", policy.Comment)
+	d.Set("document", policy.Document)
+	d.Set("name", policy.Name)
+	d.Set("type", policy.Type)
+	d.Set("version", policy.Version)
+
+	return nil
+}
+
+// resourceAwsRoute53TrafficPolicyCustomizeDiff marks the version as changing
+// when a document change creates a new version, so that references to it,
+// e.g. from a traffic policy instance, see the new version in the same apply.
+func resourceAwsRoute53TrafficPolicyCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() != "" && diff.HasChange("document") {
+		return diff.SetNewComputed("version")
+	}
+	return nil
+}
+
+func resourceAwsRoute53TrafficPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).r53conn
+
+	// A new version takes the comment along with the document, whereas only
+	// the comment of an existing version can be changed.
+	if d.HasChange("document") {
+		input := &route53.CreateTrafficPolicyVersionInput{
+			Document: aws.String(d.Get("document").(string)),
+			Id:       aws.String(d.Id()),
+		}
+		if v, ok := d.GetOk("comment"); ok {
+			input.Comment = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Creating Route53 Traffic Policy version: %s", input)
+		if _, err := conn.CreateTrafficPolicyVersion(input); err != nil {
+			return fmt.Errorf("Error creating Route53 Traffic Policy (%s) version: %s", d.Id(), err)
+		}
+	} else if d.HasChange("comment") {
+		input := &route53.UpdateTrafficPolicyCommentInput{
+			Comment: aws.String(d.Get("comment").(string)),
+			Id:      aws.String(d.Id()),
+			Version: aws.Int64(int64(d.Get("version").(int))),
+		}
+
+		log.Printf("[DEBUG] Updating Route53 Traffic Policy comment: %s", input)
+		if _, err := conn.UpdateTrafficPolicyComment(input); err != nil {
+			return fmt.Errorf("Error updating Route53 Traffic Policy (%s) comment: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsRoute53TrafficPolicyRead(d, meta)
+}
+
+func resourceAwsRoute53TrafficPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).r53conn
+
+	policies, err := listRoute53TrafficPolicyVersions(conn, d.Id())
+	if err != nil {
+		if isAWSErr(err, route53.ErrCodeNoSuchTrafficPolicy, "") {
+			return nil
+		}
+		return fmt.Errorf("Error listing Route53 Traffic Policy (%s) versions: %s", d.Id(), err)
+	}
+
+	// The policy is gone once all of its versions are deleted.
+	for _, policy := range policies {
+		log.Printf("[DEBUG] Deleting Route53 Traffic Policy %s version %d", d.Id(), aws.Int64Value(policy.Version))
+		_, err := conn.DeleteTrafficPolicy(&route53.DeleteTrafficPolicyInput{
+			Id:      policy.Id,
+			Version: policy.Version,
+		})
+		if err != nil {
+			if isAWSErr(err, route53.ErrCodeNoSuchTrafficPolicy, "") {
+				continue
+			}
+			return fmt.Errorf("Error deleting Route53 Traffic Policy (%s) version %d: %s", d.Id(), aws.Int64Value(policy.Version), err)
+		}
+	}
+
+	return nil
+}
+
+func listRoute53TrafficPolicyVersions(conn *route53.Route53, id string) ([]*route53.TrafficPolicy, error) {
+	var policies []*route53.TrafficPolicy
+
+	input := &route53.ListTrafficPolicyVersionsInput{
+		Id: aws.String(id),
+	}
+	for {
+		out, err := conn.ListTrafficPolicyVersions(input)
+		if err != nil {
+			return nil, err
+		}
+
+		policies = append(policies, out.TrafficPolicies...)
+
+		if !aws.BoolValue(out.IsTruncated) {
+			break
+		}
+		input.TrafficPolicyVersionMarker = out.TrafficPolicyVersionMarker
+	}
+
+	return policies, nil
+}
+
+// readRoute53TrafficPolicyLatestVersion returns the newest version of the
+// traffic policy, or nil if it has no versions.
+func readRoute53TrafficPolicyLatestVersion(conn *route53.Route53, id string) (*route53.TrafficPolicy, error) {
+	policies, err := listRoute53TrafficPolicyVersions(conn, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var latest *route53.TrafficPolicy
+	for _, policy := range policies {
+		if latest == nil || aws.Int64Value(policy.Version) > aws.Int64Value(latest.Version) {
+			latest = policy
+		}
+	}
+
+	return latest, nil
+}
@@ -0,0 +1,204 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+const (
+	route53TrafficPolicyInstanceStateApplied  = "Applied"
+	route53TrafficPolicyInstanceStateCreating = "Creating"
+	route53TrafficPolicyInstanceStateFailed   = "Failed"
+)
+
+func resourceAwsRoute53TrafficPolicyInstance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsRoute53TrafficPolicyInstanceCreate,
+		Read:   resourceAwsRoute53TrafficPolicyInstanceRead,
+		Update: resourceAwsRoute53TrafficPolicyInstanceUpdate,
+		Delete: resourceAwsRoute53TrafficPolicyInstanceDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"hosted_zone_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				StateFunc: func(v interface{}) string {
+					return cleanZoneID(v.(string))
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				StateFunc: func(v interface{}) string {
+					return strings.TrimSuffix(strings.ToLower(v.(string)), ".")
+				},
+			},
+			"traffic_policy_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 36),
+			},
+			"traffic_policy_version": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 1000),
+			},
+			"ttl": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+		},
+	}
+}
+
+func resourceAwsRoute53TrafficPolicyInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).r53conn
+	zone := cleanZoneID(d.Get("hosted_zone_id").(string))
+
+	input := &route53.CreateTrafficPolicyInstanceInput{
+		HostedZoneId:         aws.String(zone),
+		Name:                 aws.String(d.Get("name").(string)),
+		TTL:                  aws.Int64(int64(d.Get("ttl").(int))),
+		TrafficPolicyId:      aws.String(d.Get("traffic_policy_id").(string)),
+		TrafficPolicyVersion: aws.Int64(int64(d.Get("traffic_policy_version").(int))),
+	}
+
+	log.Printf("[DEBUG] Creating Route53 Traffic Policy Instance: %s", input)
+	unlock := lockMutation(meta, awsMutexRoute53HostedZoneKey(zone))
+	out, err := conn.CreateTrafficPolicyInstance(input)
+	unlock()
+	if err != nil {
+		return fmt.Errorf("Error creating Route53 Traffic Policy Instance: %s", err)
+	}
+
+	d.SetId(aws.StringValue(out.TrafficPolicyInstance.Id))
+
+	if err := waitForRoute53TrafficPolicyInstanceApplied(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	return resourceAwsRoute53TrafficPolicyInstanceRead(d, meta)
+}
+
+func resourceAwsRoute53TrafficPolicyInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).r53conn
+
+	out, err := conn.GetTrafficPolicyInstance(&route53.GetTrafficPolicyInstanceInput{
+		Id: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, route53.ErrCodeNoSuchTrafficPolicyInstance, "") {
+			log.Printf("[WARN] Route53 Traffic Policy Instance (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading Route53 Traffic Policy Instance (%s): %s", d.Id(), err)
+	}
+
+	instance := out.TrafficPolicyInstance
+
+	d.Set("hosted_zone_id", cleanZoneID(aws.StringValue(instance.HostedZoneId)))
+	d.Set("name", strings.TrimSuffix(strings.ToLower(aws.StringValue(instance.Name)), "."))
+	d.Set("traffic_policy_id", instance.TrafficPolicyId)
+	d.Set("traffic_policy_version", instance.TrafficPolicyVersion)
+	d.Set("ttl", instance.TTL)
+
+	return nil
+}
+
+func resourceAwsRoute53TrafficPolicyInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).r53conn
+	zone := cleanZoneID(d.Get("hosted_zone_id").(string))
+
+	input := &route53.UpdateTrafficPolicyInstanceInput{
+		Id:                   aws.String(d.Id()),
+		TTL:                  aws.Int64(int64(d.Get("ttl").(int))),
+		TrafficPolicyId:      aws.String(d.Get("traffic_policy_id").(string)),
+		TrafficPolicyVersion: aws.Int64(int64(d.Get("traffic_policy_version").(int))),
+	}
+
+	log.Printf("[DEBUG] Updating Route53 Traffic Policy Instance: %s", input)
+	unlock := lockMutation(meta, awsMutexRoute53HostedZoneKey(zone))
+	_, err := conn.UpdateTrafficPolicyInstance(input)
+	unlock()
+	if err != nil {
+		return fmt.Errorf("Error updating Route53 Traffic Policy Instance (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForRoute53TrafficPolicyInstanceApplied(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return err
+	}
+
+	return resourceAwsRoute53TrafficPolicyInstanceRead(d, meta)
+}
+
+func resourceAwsRoute53TrafficPolicyInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).r53conn
+	zone := cleanZoneID(d.Get("hosted_zone_id").(string))
+
+	log.Printf("[DEBUG] Deleting Route53 Traffic Policy Instance: %s", d.Id())
+	unlock := lockMutation(meta, awsMutexRoute53HostedZoneKey(zone))
+	_, err := conn.DeleteTrafficPolicyInstance(&route53.DeleteTrafficPolicyInstanceInput{
+		Id: aws.String(d.Id()),
+	})
+	unlock()
+	if err != nil {
+		if isAWSErr(err, route53.ErrCodeNoSuchTrafficPolicyInstance, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Route53 Traffic Policy Instance (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func waitForRoute53TrafficPolicyInstanceApplied(conn *route53.Route53, id string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{route53TrafficPolicyInstanceStateCreating},
+		Target:     []string{route53TrafficPolicyInstanceStateApplied},
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+		Refresh: func() (interface{}, string, error) {
+			out, err := conn.GetTrafficPolicyInstance(&route53.GetTrafficPolicyInstanceInput{
+				Id: aws.String(id),
+			})
+			if err != nil {
+				return nil, "", err
+			}
+
+			instance := out.TrafficPolicyInstance
+			state := aws.StringValue(instance.State)
+			if state == route53TrafficPolicyInstanceStateFailed {
+				return instance, state, fmt.Errorf("%s", aws.StringValue(instance.Message))
+			}
+
+			return instance, state, nil
+		},
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for Route53 Traffic Policy Instance (%s) to be applied: %s", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,126 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSRoute53TrafficPolicyInstance_basic(t *testing.T) {
+	var instance route53.TrafficPolicyInstance
+	resourceName := "aws_route53_traffic_policy_instance.test"
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(8))
+	zoneName := fmt.Sprintf("%s.com", rName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRoute53TrafficPolicyInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoute53TrafficPolicyInstanceConfig(rName, zoneName, "192.0.2.1", 3600),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoute53TrafficPolicyInstanceExists(resourceName, &instance),
+					resource.TestCheckResourceAttrPair(resourceName, "hosted_zone_id", "aws_route53_zone.test", "zone_id"),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("www.%s", zoneName)),
+					resource.TestCheckResourceAttrPair(resourceName, "traffic_policy_id", "aws_route53_traffic_policy.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_policy_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ttl", "3600"),
+				),
+			},
+			{
+				Config: testAccRoute53TrafficPolicyInstanceConfig(rName, zoneName, "192.0.2.1", 7200),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoute53TrafficPolicyInstanceExists(resourceName, &instance),
+					resource.TestCheckResourceAttr(resourceName, "ttl", "7200"),
+				),
+			},
+			{
+				// The instance must move to the new policy version in the
+				// same apply that creates it.
+				Config: testAccRoute53TrafficPolicyInstanceConfig(rName, zoneName, "192.0.2.2", 7200),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoute53TrafficPolicyInstanceExists(resourceName, &instance),
+					resource.TestCheckResourceAttr("aws_route53_traffic_policy.test", "version", "2"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_policy_version", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckRoute53TrafficPolicyInstanceExists(n string, instance *route53.TrafficPolicyInstance) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Route53 Traffic Policy Instance ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).r53conn
+		out, err := conn.GetTrafficPolicyInstance(&route53.GetTrafficPolicyInstanceInput{
+			Id: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		*instance = *out.TrafficPolicyInstance
+		return nil
+	}
+}
+
+func testAccCheckRoute53TrafficPolicyInstanceDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).r53conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_route53_traffic_policy_instance" {
+			continue
+		}
+
+		_, err := conn.GetTrafficPolicyInstance(&route53.GetTrafficPolicyInstanceInput{
+			Id: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			if isAWSErr(err, route53.ErrCodeNoSuchTrafficPolicyInstance, "") {
+				continue
+			}
+			return err
+		}
+
+		return fmt.Errorf("Route53 Traffic Policy Instance %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccRoute53TrafficPolicyInstanceConfig(rName, zoneName, address string, ttl int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "aws_route53_zone" "test" {
+  name = "%s"
+}
+
+resource "aws_route53_traffic_policy_instance" "test" {
+  hosted_zone_id         = "${aws_route53_zone.test.zone_id}"
+  name                   = "www.%s"
+  traffic_policy_id      = "${aws_route53_traffic_policy.test.id}"
+  traffic_policy_version = "${aws_route53_traffic_policy.test.version}"
+  ttl                    = %d
+}
+`, testAccRoute53TrafficPolicyConfig(rName, "comment", address), zoneName, zoneName, ttl)
+}
@@ -0,0 +1,129 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSRoute53TrafficPolicy_basic(t *testing.T) {
+	var policy route53.TrafficPolicy
+	resourceName := "aws_route53_traffic_policy.test"
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(8))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRoute53TrafficPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoute53TrafficPolicyConfig(rName, "comment1", "192.0.2.1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoute53TrafficPolicyExists(resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, "comment", "comment1"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "type", "A"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				Config: testAccRoute53TrafficPolicyConfig(rName, "comment2", "192.0.2.1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoute53TrafficPolicyExists(resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, "comment", "comment2"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				Config: testAccRoute53TrafficPolicyConfig(rName, "comment3", "192.0.2.2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoute53TrafficPolicyExists(resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, "comment", "comment3"),
+					resource.TestCheckResourceAttr(resourceName, "version", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckRoute53TrafficPolicyExists(n string, policy *route53.TrafficPolicy) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Route53 Traffic Policy ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).r53conn
+		latest, err := readRoute53TrafficPolicyLatestVersion(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if latest == nil {
+			return fmt.Errorf("Route53 Traffic Policy (%s) not found", rs.Primary.ID)
+		}
+
+		*policy = *latest
+		return nil
+	}
+}
+
+func testAccCheckRoute53TrafficPolicyDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).r53conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_route53_traffic_policy" {
+			continue
+		}
+
+		latest, err := readRoute53TrafficPolicyLatestVersion(conn, rs.Primary.ID)
+		if err != nil {
+			if isAWSErr(err, route53.ErrCodeNoSuchTrafficPolicy, "") {
+				continue
+			}
+			return err
+		}
+
+		if latest != nil {
+			return fmt.Errorf("Route53 Traffic Policy %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccRoute53TrafficPolicyConfig(rName, comment, address string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_traffic_policy" "test" {
+  name    = "%s"
+  comment = "%s"
+
+  document = <<DOCUMENT
+{
+  "AWSPolicyFormatVersion": "2015-10-01",
+  "RecordType": "A",
+  "Endpoints": {
+    "endpoint": {
+      "Type": "value",
+      "Value": "%s"
+    }
+  },
+  "StartEndpoint": "endpoint"
+}
+DOCUMENT
+}
+`, rName, comment, address)
+}
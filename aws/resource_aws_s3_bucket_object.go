@@ -11,6 +11,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/mitchellh/go-homedir"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -26,6 +27,8 @@ func resourceAwsS3BucketObject() *schema.Resource {
 		Update: resourceAwsS3BucketObjectPut,
 		Delete: resourceAwsS3BucketObjectDelete,
 
+		CustomizeDiff: resourceAwsS3BucketObjectCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"bucket": {
 				Type:     schema.TypeString,
@@ -84,6 +87,23 @@ func resourceAwsS3BucketObject() *schema.Resource {
 				ConflictsWith: []string{"source"},
 			},
 
+			"source_hash": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"multipart_part_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(int(s3MinUploadPartSize)),
+			},
+
+			"multipart_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+
 			"storage_class": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -101,13 +121,13 @@ func resourceAwsS3BucketObject() *schema.Resource {
 			"kms_key_id": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: validateArn,
+				ValidateFunc: validateKmsKey,
 			},
 
 			"etag": {
 				Type: schema.TypeString,
-				// This will conflict with SSE-C and SSE-KMS encryption and multi-part upload
-				// if/when it's actually implemented. The Etag then won't match raw-file MD5.
+				// This will conflict with SSE-C and SSE-KMS encryption. The Etag then won't
+				// match raw-file MD5. Multipart uploads store the MD5 computed during upload.
 				// See http://docs.aws.amazon.com/AmazonS3/latest/API/RESTCommonResponseHeaders.html
 				Optional:      true,
 				Computed:      true,
@@ -134,7 +154,12 @@ func resourceAwsS3BucketObjectPut(d *schema.ResourceData, meta interface{}) erro
 
 	restricted := meta.(*AWSClient).IsGovCloud() || meta.(*AWSClient).IsChinaCloud()
 
-	var body io.ReadSeeker
+	var body interface {
+		io.ReadSeeker
+		io.ReaderAt
+	}
+	var size int64
+	var sourceHash string
 
 	if v, ok := d.GetOk("source"); ok {
 		source := v.(string)
@@ -146,11 +171,28 @@ func resourceAwsS3BucketObjectPut(d *schema.ResourceData, meta interface{}) erro
 		if err != nil {
 			return fmt.Errorf("Error opening S3 bucket object source (%s): %s", source, err)
 		}
+		defer file.Close()
+
+		info, err := file.Stat()
+		if err != nil {
+			return fmt.Errorf("Error reading S3 bucket object source (%s): %s", source, err)
+		}
+
+		sourceHash, err = md5Reader(file)
+		if err != nil {
+			return fmt.Errorf("Error hashing S3 bucket object source (%s): %s", source, err)
+		}
+		if _, err := file.Seek(0, io.SeekStart); err != nil {
+			return fmt.Errorf("Error reading S3 bucket object source (%s): %s", source, err)
+		}
 
 		body = file
+		size = info.Size()
 	} else if v, ok := d.GetOk("content"); ok {
 		content := v.(string)
-		body = bytes.NewReader([]byte(content))
+		reader := bytes.NewReader([]byte(content))
+		body = reader
+		size = reader.Size()
 	} else {
 		return fmt.Errorf("Must specify \"source\" or \"content\" field")
 	}
@@ -215,15 +257,62 @@ func resourceAwsS3BucketObjectPut(d *schema.ResourceData, meta interface{}) erro
 		putInput.WebsiteRedirectLocation = aws.String(v.(string))
 	}
 
-	resp, err := s3conn.PutObject(putInput)
-	if err != nil {
-		return fmt.Errorf("Error putting object in S3 bucket (%s): %s", bucket, err)
+	// Multipart uploads are opt-in, as they change the ETag of the object
+	// from the MD5 of its content to the "<md5>-<parts>" form.
+	var partSize int64
+	multipart := false
+	if v, ok := d.GetOk("multipart_part_size"); ok {
+		partSize = s3UploadPartSize(size, int64(v.(int)))
+		multipart = size > partSize
 	}
 
-	// See https://forums.aws.amazon.com/thread.jspa?threadID=44003
-	d.Set("etag", strings.Trim(*resp.ETag, `"`))
+	if multipart {
+		resp, err := s3MultipartUpload(s3conn, &s3.CreateMultipartUploadInput{
+			Bucket:                  putInput.Bucket,
+			Key:                     putInput.Key,
+			ACL:                     putInput.ACL,
+			StorageClass:            putInput.StorageClass,
+			CacheControl:            putInput.CacheControl,
+			ContentType:             putInput.ContentType,
+			ContentEncoding:         putInput.ContentEncoding,
+			ContentLanguage:         putInput.ContentLanguage,
+			ContentDisposition:      putInput.ContentDisposition,
+			ServerSideEncryption:    putInput.ServerSideEncryption,
+			SSEKMSKeyId:             putInput.SSEKMSKeyId,
+			Tagging:                 putInput.Tagging,
+			WebsiteRedirectLocation: putInput.WebsiteRedirectLocation,
+		}, body, size, partSize, d.Get("multipart_concurrency").(int))
+		if err != nil {
+			return fmt.Errorf("Error putting object in S3 bucket (%s): %s", bucket, err)
+		}
+
+		// The ETag of a multipart upload is not the MD5 of the object content,
+		// so store the MD5 computed from the source instead.
+		if sourceHash == "" {
+			sourceHash, err = md5Reader(io.NewSectionReader(body, 0, size))
+			if err != nil {
+				return fmt.Errorf("Error hashing S3 bucket object content: %s", err)
+			}
+		}
+		d.Set("etag", sourceHash)
+		d.Set("version_id", resp.VersionId)
+	} else {
+		resp, err := s3conn.PutObject(putInput)
+		if err != nil {
+			return fmt.Errorf("Error putting object in S3 bucket (%s): %s", bucket, err)
+		}
+
+		// See https://forums.aws.amazon.com/thread.jspa?threadID=44003
+		d.Set("etag", strings.Trim(*resp.ETag, `"`))
+		d.Set("version_id", resp.VersionId)
+	}
+
+	if _, ok := d.GetOk("source"); ok {
+		d.Set("source_hash", sourceHash)
+	} else {
+		d.Set("source_hash", "")
+	}
 
-	d.Set("version_id", resp.VersionId)
 	d.SetId(key)
 	return resourceAwsS3BucketObjectRead(d, meta)
 }
@@ -275,10 +364,25 @@ func resourceAwsS3BucketObjectRead(d *schema.ResourceData, meta interface{}) err
 
 		if *resp.SSEKMSKeyId != *kmsresp.KeyMetadata.Arn {
 			log.Printf("[DEBUG] S3 object is encrypted using a non-default KMS Key ID: %s", *resp.SSEKMSKeyId)
-			d.Set("kms_key_id", resp.SSEKMSKeyId)
+			d.Set("kms_key_id", kmsKeyIdStateValue(d.Get("kms_key_id").(string), *resp.SSEKMSKeyId))
+		}
+	}
+	// Objects created before source_hash was introduced have no hash in
+	// state, record the current one so that upgrading does not show a diff.
+	if source, ok := d.GetOk("source"); ok && d.Get("source_hash").(string) == "" {
+		hash, err := s3ObjectSourceHash(source.(string))
+		if err != nil {
+			log.Printf("[WARN] Unable to hash S3 bucket object source (%s): %s", source, err)
+		} else {
+			d.Set("source_hash", hash)
 		}
 	}
-	d.Set("etag", strings.Trim(*resp.ETag, `"`))
+
+	// The ETag of an object uploaded in multiple parts is not an MD5 of its
+	// content, keep the MD5 computed during upload instead.
+	if etag := strings.Trim(aws.StringValue(resp.ETag), `"`); !strings.Contains(etag, "-") {
+		d.Set("etag", etag)
+	}
 
 	// The "STANDARD" (which is also the default) storage
 	// class when set would not be included in the results.
@@ -302,6 +406,33 @@ func resourceAwsS3BucketObjectRead(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
+func resourceAwsS3BucketObjectCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	source, ok := diff.GetOk("source")
+	if !ok {
+		return nil
+	}
+
+	// Hash the source file here so changes to large files are detected
+	// without having to read them into memory via md5(file(...)).
+	hash, err := s3ObjectSourceHash(source.(string))
+	if err != nil {
+		log.Printf("[WARN] Unable to hash S3 bucket object source (%s): %s", source, err)
+		return nil
+	}
+
+	// An empty hash is recorded on the next read rather than diffed, as it
+	// only means the object predates source_hash.
+	if old := diff.Get("source_hash").(string); old != "" && old != hash {
+		return diff.SetNew("source_hash", hash)
+	}
+
+	return nil
+}
+
 func resourceAwsS3BucketObjectDelete(d *schema.ResourceData, meta interface{}) error {
 	s3conn := meta.(*AWSClient).s3conn
 
@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"bytes"
+	"crypto/md5"
 	"fmt"
 	"io/ioutil"
 	"os"
@@ -44,6 +46,39 @@ func TestAccAWSS3BucketObject_source(t *testing.T) {
 	})
 }
 
+func TestAccAWSS3BucketObject_multipart(t *testing.T) {
+	tmpFile, err := ioutil.TempFile("", "tf-acc-s3-obj-multipart")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(tmpFile.Name())
+
+	rInt := acctest.RandInt()
+	// write enough data to the tempfile that it is uploaded in three parts
+	content := bytes.Repeat([]byte("0123456789"), 1024*1024+1)
+	err = ioutil.WriteFile(tmpFile.Name(), content, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var obj s3.GetObjectOutput
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketObjectDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSS3BucketObjectConfigMultipart(rInt, tmpFile.Name()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketObjectExists("aws_s3_bucket_object.object", &obj),
+					resource.TestCheckResourceAttr("aws_s3_bucket_object.object", "etag", fmt.Sprintf("%x", md5.Sum(content))),
+					resource.TestCheckResourceAttr("aws_s3_bucket_object.object", "source_hash", fmt.Sprintf("%x", md5.Sum(content))),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSS3BucketObject_content(t *testing.T) {
 	rInt := acctest.RandInt()
 	var obj s3.GetObjectOutput
@@ -569,6 +604,22 @@ resource "aws_s3_bucket_object" "object" {
 `, randInt, source)
 }
 
+func testAccAWSS3BucketObjectConfigMultipart(randInt int, source string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "object_bucket" {
+	bucket = "tf-object-test-bucket-%d"
+}
+
+resource "aws_s3_bucket_object" "object" {
+	bucket                = "${aws_s3_bucket.object_bucket.bucket}"
+	key                   = "test-key"
+	source                = "%s"
+	multipart_part_size   = 5242880
+	multipart_concurrency = 2
+}
+`, randInt, source)
+}
+
 func testAccAWSS3BucketObjectConfig_withContentCharacteristics(randInt int, source string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "object_bucket_2" {
@@ -78,6 +78,12 @@ func resourceAwsS3BucketPolicyRead(d *schema.ResourceData, meta interface{}) err
 		Bucket: aws.String(d.Id()),
 	})
 
+	if isAWSErr(err, "NoSuchBucket", "") || isAWSErr(err, "NoSuchBucketPolicy", "") {
+		log.Printf("[WARN] S3 bucket policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
 	v := ""
 	if err == nil && pol.Policy != nil {
 		v = *pol.Policy
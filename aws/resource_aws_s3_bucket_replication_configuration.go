@@ -0,0 +1,349 @@
+package aws
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsS3BucketReplicationConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsS3BucketReplicationConfigurationPut,
+		Read:   resourceAwsS3BucketReplicationConfigurationRead,
+		Update: resourceAwsS3BucketReplicationConfigurationPut,
+		Delete: resourceAwsS3BucketReplicationConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"role": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArn,
+			},
+
+			"rules": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Set:      s3ReplicationRuleHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validateS3BucketReplicationRuleId,
+						},
+						"prefix": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateS3BucketReplicationRulePrefix,
+						},
+						"status": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateS3BucketReplicationRuleStatus,
+						},
+						"destination": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bucket": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateArn,
+									},
+									"storage_class": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validateS3BucketReplicationDestinationStorageClass,
+									},
+									"replica_kms_key_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"account_id": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validateAwsAccountId,
+									},
+									"access_control_translation": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"owner": {
+													Type:     schema.TypeString,
+													Required: true,
+													ValidateFunc: validation.StringInSlice([]string{
+														s3.OwnerOverrideDestination,
+													}, false),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"source_selection_criteria": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"sse_kms_encrypted_objects": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"enabled": {
+													Type:     schema.TypeBool,
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsS3BucketReplicationConfigurationPut(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn
+
+	bucket := d.Get("bucket").(string)
+
+	rc := &s3.ReplicationConfiguration{
+		Role:  aws.String(d.Get("role").(string)),
+		Rules: expandS3ReplicationRules(d.Get("rules").(*schema.Set).List()),
+	}
+
+	input := &s3.PutBucketReplicationInput{
+		Bucket:                   aws.String(bucket),
+		ReplicationConfiguration: rc,
+	}
+	log.Printf("[DEBUG] S3 put bucket replication configuration: %s", input)
+
+	_, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+		return s3conn.PutBucketReplication(input)
+	})
+	if err != nil {
+		return fmt.Errorf("Error putting S3 replication configuration for bucket %q: %s", bucket, err)
+	}
+
+	d.SetId(bucket)
+
+	return resourceAwsS3BucketReplicationConfigurationRead(d, meta)
+}
+
+func resourceAwsS3BucketReplicationConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn
+
+	log.Printf("[DEBUG] S3 bucket replication configuration, read for bucket: %s", d.Id())
+	resp, err := s3conn.GetBucketReplication(&s3.GetBucketReplicationInput{
+		Bucket: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, "NoSuchBucket", "") || isAWSErr(err, "ReplicationConfigurationNotFoundError", "") {
+			log.Printf("[WARN] S3 bucket replication configuration for %q not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading S3 replication configuration for bucket %q: %s", d.Id(), err)
+	}
+
+	rc := resp.ReplicationConfiguration
+	if rc == nil {
+		log.Printf("[WARN] S3 bucket replication configuration for %q not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("bucket", d.Id())
+	d.Set("role", rc.Role)
+	if err := d.Set("rules", flattenS3ReplicationRules(rc.Rules)); err != nil {
+		return fmt.Errorf("Error setting rules: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsS3BucketReplicationConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn
+
+	log.Printf("[DEBUG] S3 bucket: %s, delete replication configuration", d.Id())
+	_, err := s3conn.DeleteBucketReplication(&s3.DeleteBucketReplicationInput{
+		Bucket: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, "NoSuchBucket", "") {
+			return nil
+		}
+		return fmt.Errorf("Error removing S3 replication configuration for bucket %q: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// s3ReplicationRuleHash leaves out the rule ID, which S3 generates when it
+// is not configured, so that such rules do not show a perpetual diff.
+func s3ReplicationRuleHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+
+	if v, ok := m["prefix"]; ok {
+		buf.WriteString(fmt.Sprintf("%s-", v.(string)))
+	}
+	if v, ok := m["status"]; ok {
+		buf.WriteString(fmt.Sprintf("%s-", v.(string)))
+	}
+	return hashcode.String(buf.String())
+}
+
+func expandS3ReplicationRules(l []interface{}) []*s3.ReplicationRule {
+	rules := make([]*s3.ReplicationRule, 0, len(l))
+
+	for _, v := range l {
+		rr := v.(map[string]interface{})
+
+		rule := &s3.ReplicationRule{
+			Prefix: aws.String(rr["prefix"].(string)),
+			Status: aws.String(rr["status"].(string)),
+		}
+
+		if v, ok := rr["id"].(string); ok && v != "" {
+			rule.ID = aws.String(v)
+		}
+
+		if l, ok := rr["destination"].([]interface{}); ok && len(l) > 0 && l[0] != nil {
+			rule.Destination = expandS3ReplicationDestination(l[0].(map[string]interface{}))
+		}
+
+		if l, ok := rr["source_selection_criteria"].([]interface{}); ok && len(l) > 0 && l[0] != nil {
+			rule.SourceSelectionCriteria = expandS3ReplicationSourceSelectionCriteria(l[0].(map[string]interface{}))
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules
+}
+
+func expandS3ReplicationDestination(m map[string]interface{}) *s3.Destination {
+	destination := &s3.Destination{
+		Bucket: aws.String(m["bucket"].(string)),
+	}
+
+	if v, ok := m["storage_class"].(string); ok && v != "" {
+		destination.StorageClass = aws.String(v)
+	}
+
+	if v, ok := m["replica_kms_key_id"].(string); ok && v != "" {
+		destination.EncryptionConfiguration = &s3.EncryptionConfiguration{
+			ReplicaKmsKeyID: aws.String(v),
+		}
+	}
+
+	if v, ok := m["account_id"].(string); ok && v != "" {
+		destination.Account = aws.String(v)
+	}
+
+	if l, ok := m["access_control_translation"].([]interface{}); ok && len(l) > 0 && l[0] != nil {
+		act := l[0].(map[string]interface{})
+		destination.AccessControlTranslation = &s3.AccessControlTranslation{
+			Owner: aws.String(act["owner"].(string)),
+		}
+	}
+
+	return destination
+}
+
+func expandS3ReplicationSourceSelectionCriteria(m map[string]interface{}) *s3.SourceSelectionCriteria {
+	criteria := &s3.SourceSelectionCriteria{}
+
+	if l, ok := m["sse_kms_encrypted_objects"].([]interface{}); ok && len(l) > 0 && l[0] != nil {
+		status := s3.SseKmsEncryptedObjectsStatusDisabled
+		if l[0].(map[string]interface{})["enabled"].(bool) {
+			status = s3.SseKmsEncryptedObjectsStatusEnabled
+		}
+		criteria.SseKmsEncryptedObjects = &s3.SseKmsEncryptedObjects{
+			Status: aws.String(status),
+		}
+	}
+
+	return criteria
+}
+
+func flattenS3ReplicationRules(rules []*s3.ReplicationRule) []interface{} {
+	l := make([]interface{}, 0, len(rules))
+
+	for _, rule := range rules {
+		if rule == nil {
+			continue
+		}
+
+		m := map[string]interface{}{
+			"id":     aws.StringValue(rule.ID),
+			"prefix": aws.StringValue(rule.Prefix),
+			"status": aws.StringValue(rule.Status),
+		}
+
+		if dest := rule.Destination; dest != nil {
+			dm := map[string]interface{}{
+				"bucket":        aws.StringValue(dest.Bucket),
+				"storage_class": aws.StringValue(dest.StorageClass),
+				"account_id":    aws.StringValue(dest.Account),
+			}
+			if dest.EncryptionConfiguration != nil {
+				dm["replica_kms_key_id"] = aws.StringValue(dest.EncryptionConfiguration.ReplicaKmsKeyID)
+			}
+			if dest.AccessControlTranslation != nil {
+				dm["access_control_translation"] = []interface{}{
+					map[string]interface{}{
+						"owner": aws.StringValue(dest.AccessControlTranslation.Owner),
+					},
+				}
+			}
+			m["destination"] = []interface{}{dm}
+		}
+
+		if ssc := rule.SourceSelectionCriteria; ssc != nil && ssc.SseKmsEncryptedObjects != nil {
+			m["source_selection_criteria"] = []interface{}{
+				map[string]interface{}{
+					"sse_kms_encrypted_objects": []interface{}{
+						map[string]interface{}{
+							"enabled": aws.StringValue(ssc.SseKmsEncryptedObjects.Status) == s3.SseKmsEncryptedObjectsStatusEnabled,
+						},
+					},
+				},
+			}
+		}
+
+		l = append(l, m)
+	}
+
+	return l
+}
@@ -0,0 +1,229 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSS3BucketReplicationConfiguration_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "aws_s3_bucket_replication_configuration.replication"
+
+	var providers []*schema.Provider
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccAlternateRegionPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactories(&providers),
+		CheckDestroy:      testAccCheckAWSS3BucketReplicationConfigurationDestroyWithProviders(&providers),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketReplicationConfigurationConfig(rInt, "STANDARD"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketReplicationConfigurationExistsWithProviders(resourceName, &providers),
+					resource.TestCheckResourceAttr(resourceName, "rules.#", "1"),
+				),
+			},
+			{
+				Config: testAccAWSS3BucketReplicationConfigurationConfig(rInt, "STANDARD_IA"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketReplicationConfigurationExistsWithProviders(resourceName, &providers),
+					resource.TestCheckResourceAttr(resourceName, "rules.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSS3BucketReplicationConfiguration_sseKmsEncryptedObjects(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "aws_s3_bucket_replication_configuration.replication"
+
+	var providers []*schema.Provider
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccAlternateRegionPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactories(&providers),
+		CheckDestroy:      testAccCheckAWSS3BucketReplicationConfigurationDestroyWithProviders(&providers),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketReplicationConfigurationConfigSseKmsEncryptedObjects(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketReplicationConfigurationExistsWithProviders(resourceName, &providers),
+					resource.TestCheckResourceAttr(resourceName, "rules.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSS3BucketReplicationConfigurationExistsWithProviders(n string, providers *[]*schema.Provider) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		found := false
+		err := testAccCheckWithProviders(func(s *terraform.State, provider *schema.Provider) error {
+			conn := provider.Meta().(*AWSClient).s3conn
+			_, err := conn.GetBucketReplication(&s3.GetBucketReplicationInput{
+				Bucket: aws.String(rs.Primary.ID),
+			})
+			if err != nil {
+				if isAWSErr(err, "NoSuchBucket", "") || isAWSErr(err, "ReplicationConfigurationNotFoundError", "") {
+					return nil
+				}
+				return err
+			}
+
+			found = true
+			return nil
+		}, providers)(s)
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			return fmt.Errorf("S3 bucket replication configuration not found: %s", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSS3BucketReplicationConfigurationDestroyWithProviders(providers *[]*schema.Provider) resource.TestCheckFunc {
+	return testAccCheckWithProviders(testAccCheckAWSS3BucketReplicationConfigurationDestroyWithProvider, providers)
+}
+
+func testAccCheckAWSS3BucketReplicationConfigurationDestroyWithProvider(s *terraform.State, provider *schema.Provider) error {
+	conn := provider.Meta().(*AWSClient).s3conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_s3_bucket_replication_configuration" {
+			continue
+		}
+
+		_, err := conn.GetBucketReplication(&s3.GetBucketReplicationInput{
+			Bucket: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			if isAWSErr(err, "NoSuchBucket", "") || isAWSErr(err, "ReplicationConfigurationNotFoundError", "") {
+				continue
+			}
+			return err
+		}
+
+		return fmt.Errorf("S3 bucket replication configuration still exists: %s", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+const testAccAWSS3BucketReplicationConfigurationConfigBase = `
+resource "aws_iam_role" "role" {
+  name               = "tf-iam-role-replication-%d"
+  assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "s3.amazonaws.com"
+      },
+      "Effect": "Allow",
+      "Sid": ""
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_s3_bucket" "source" {
+  bucket = "tf-test-bucket-source-%d"
+
+  versioning {
+    enabled = true
+  }
+}
+
+resource "aws_s3_bucket" "destination" {
+  provider = "aws.alternate"
+  bucket   = "tf-test-bucket-destination-%d"
+  region   = %q
+
+  versioning {
+    enabled = true
+  }
+}
+`
+
+func testAccAWSS3BucketReplicationConfigurationConfig(randInt int, storageClass string) string {
+	return fmt.Sprintf(testAccAlternateRegionProviderConfig()+testAccAWSS3BucketReplicationConfigurationConfigBase+`
+resource "aws_s3_bucket_replication_configuration" "replication" {
+  bucket = "${aws_s3_bucket.source.id}"
+  role   = "${aws_iam_role.role.arn}"
+
+  rules {
+    id     = "foobar"
+    prefix = "foo"
+    status = "Enabled"
+
+    destination {
+      bucket        = "${aws_s3_bucket.destination.arn}"
+      storage_class = "%s"
+    }
+  }
+}
+`, randInt, randInt, randInt, testAccGetAlternateRegion(), storageClass)
+}
+
+func testAccAWSS3BucketReplicationConfigurationConfigSseKmsEncryptedObjects(randInt int) string {
+	return fmt.Sprintf(testAccAlternateRegionProviderConfig()+testAccAWSS3BucketReplicationConfigurationConfigBase+`
+resource "aws_kms_key" "replica" {
+  provider                = "aws.alternate"
+  description             = "TF Acceptance Test S3 repl KMS key"
+  deletion_window_in_days = 7
+}
+
+resource "aws_s3_bucket_replication_configuration" "replication" {
+  bucket = "${aws_s3_bucket.source.id}"
+  role   = "${aws_iam_role.role.arn}"
+
+  rules {
+    id     = "foobar"
+    prefix = "foo"
+    status = "Enabled"
+
+    destination {
+      bucket             = "${aws_s3_bucket.destination.arn}"
+      storage_class      = "STANDARD"
+      replica_kms_key_id = "${aws_kms_key.replica.arn}"
+    }
+
+    source_selection_criteria {
+      sse_kms_encrypted_objects {
+        enabled = true
+      }
+    }
+  }
+}
+`, randInt, randInt, randInt, testAccGetAlternateRegion())
+}
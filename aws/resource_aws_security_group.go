@@ -724,15 +724,55 @@ func resourceAwsSecurityGroupUpdateRules(
 		os := o.(*schema.Set)
 		ns := n.(*schema.Set)
 
-		remove, err := expandIPPerms(group, os.Difference(ns).List())
+		// Rules that only differ by description are updated in place rather
+		// than being revoked and authorized again.
+		removeRules, addRules, updateRules := securityGroupRuleDescriptionChanges(os.Difference(ns).List(), ns.Difference(os).List())
+
+		remove, err := expandIPPerms(group, removeRules)
+		if err != nil {
+			return err
+		}
+		add, err := expandIPPerms(group, addRules)
 		if err != nil {
 			return err
 		}
-		add, err := expandIPPerms(group, ns.Difference(os).List())
+		update, err := expandIPPerms(group, updateRules)
 		if err != nil {
 			return err
 		}
 
+		if len(update) > 0 {
+			conn := meta.(*AWSClient).ec2conn
+
+			log.Printf("[DEBUG] Updating security group %#v %s rule descriptions: %#v",
+				group, ruleset, update)
+
+			var err error
+			if ruleset == "egress" {
+				req := &ec2.UpdateSecurityGroupRuleDescriptionsEgressInput{
+					GroupId:       group.GroupId,
+					IpPermissions: update,
+				}
+				_, err = conn.UpdateSecurityGroupRuleDescriptionsEgress(req)
+			} else {
+				req := &ec2.UpdateSecurityGroupRuleDescriptionsIngressInput{
+					GroupId:       group.GroupId,
+					IpPermissions: update,
+				}
+				if group.VpcId == nil || *group.VpcId == "" {
+					req.GroupId = nil
+					req.GroupName = group.GroupName
+				}
+				_, err = conn.UpdateSecurityGroupRuleDescriptionsIngress(req)
+			}
+
+			if err != nil {
+				return fmt.Errorf(
+					"Error updating security group %s rule descriptions: %s",
+					ruleset, err)
+			}
+		}
+
 		// TODO: We need to handle partial state better in the in-between
 		// in this update.
 
@@ -809,6 +849,45 @@ func resourceAwsSecurityGroupUpdateRules(
 	return nil
 }
 
+// securityGroupRuleDescriptionChanges splits the rules being removed and
+// added into those that must still be revoked and authorized, and the added
+// rules which match a removed rule in everything but their description.
+func securityGroupRuleDescriptionChanges(remove, add []interface{}) ([]interface{}, []interface{}, []interface{}) {
+	removed := make(map[int]int)
+	for i, r := range remove {
+		removed[resourceAwsSecurityGroupRuleHashWithoutDescription(r)] = i
+	}
+
+	var update, newAdd []interface{}
+	matched := make(map[int]bool)
+	for _, r := range add {
+		if i, ok := removed[resourceAwsSecurityGroupRuleHashWithoutDescription(r)]; ok && !matched[i] {
+			matched[i] = true
+			update = append(update, r)
+			continue
+		}
+		newAdd = append(newAdd, r)
+	}
+
+	var newRemove []interface{}
+	for i, r := range remove {
+		if !matched[i] {
+			newRemove = append(newRemove, r)
+		}
+	}
+
+	return newRemove, newAdd, update
+}
+
+func resourceAwsSecurityGroupRuleHashWithoutDescription(v interface{}) int {
+	m := make(map[string]interface{})
+	for k, v := range v.(map[string]interface{}) {
+		m[k] = v
+	}
+	m["description"] = ""
+	return resourceAwsSecurityGroupRuleHash(m)
+}
+
 // SGStateRefreshFunc returns a resource.StateRefreshFunc that is used to watch
 // a security group.
 func SGStateRefreshFunc(conn *ec2.EC2, id string) resource.StateRefreshFunc {
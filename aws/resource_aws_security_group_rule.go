@@ -766,7 +766,8 @@ func descriptionFromIPPerm(d *schema.ResourceData, rule *ec2.IpPermission) strin
 
 	if len(groupIds) > 0 {
 		for _, gp := range rule.UserIdGroupPairs {
-			if _, ok := groupIds[*gp.GroupId]; !ok {
+			// EC2-Classic rules reference the source group by name
+			if !groupIds[aws.StringValue(gp.GroupId)] && !groupIds[aws.StringValue(gp.GroupName)] {
 				continue
 			}
 
@@ -779,7 +780,7 @@ func descriptionFromIPPerm(d *schema.ResourceData, rule *ec2.IpPermission) strin
 	return ""
 }
 
-// Validates that either 'cidr_blocks', 'ipv6_cidr_blocks', 'self', or 'source_security_group_id' is set
+// Validates that either 'cidr_blocks', 'ipv6_cidr_blocks', 'self', 'source_security_group_id', or 'prefix_list_ids' is set
 func validateAwsSecurityGroupRule(d *schema.ResourceData) error {
 	blocks, blocksOk := d.GetOk("cidr_blocks")
 	self, selfOk := d.GetOk("self")
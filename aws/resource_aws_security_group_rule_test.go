@@ -12,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 )
 
@@ -108,6 +109,49 @@ func TestIpPermissionIDHash(t *testing.T) {
 	}
 }
 
+func TestDescriptionFromIPPerm_sourceSecurityGroup(t *testing.T) {
+	cases := []struct {
+		Source string
+		Pair   *ec2.UserIdGroupPair
+	}{
+		{
+			Source: "sg-12345",
+			Pair: &ec2.UserIdGroupPair{
+				GroupId:     aws.String("sg-12345"),
+				Description: aws.String("from vpc group"),
+			},
+		},
+		{
+			// EC2-Classic rules reference the source group by name
+			Source: "classic-group",
+			Pair: &ec2.UserIdGroupPair{
+				GroupId:     aws.String("sg-67890"),
+				GroupName:   aws.String("classic-group"),
+				Description: aws.String("from classic group"),
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		d := schema.TestResourceDataRaw(t, resourceAwsSecurityGroupRule().Schema, map[string]interface{}{
+			"type":                     "ingress",
+			"from_port":                80,
+			"to_port":                  80,
+			"protocol":                 "tcp",
+			"security_group_id":        "sg-00000",
+			"source_security_group_id": tc.Source,
+		})
+
+		rule := &ec2.IpPermission{
+			UserIdGroupPairs: []*ec2.UserIdGroupPair{tc.Pair},
+		}
+
+		if actual := descriptionFromIPPerm(d, rule); actual != aws.StringValue(tc.Pair.Description) {
+			t.Errorf("source %s: expected description %q, got %q", tc.Source, aws.StringValue(tc.Pair.Description), actual)
+		}
+	}
+}
+
 func TestAccAWSSecurityGroupRule_Ingress_VPC(t *testing.T) {
 	var group ec2.SecurityGroup
 	rInt := acctest.RandInt()
@@ -337,6 +337,40 @@ func TestResourceAwsSecurityGroupIPPermGather(t *testing.T) {
 	}
 }
 
+func TestSecurityGroupRuleDescriptionChanges(t *testing.T) {
+	rule := func(port int, cidr, description string) map[string]interface{} {
+		return map[string]interface{}{
+			"from_port":   port,
+			"to_port":     port,
+			"protocol":    "tcp",
+			"self":        false,
+			"cidr_blocks": []interface{}{cidr},
+			"description": description,
+		}
+	}
+
+	remove := []interface{}{
+		rule(80, "10.0.0.0/8", "old"),
+		rule(443, "10.0.0.0/8", ""),
+	}
+	add := []interface{}{
+		rule(80, "10.0.0.0/8", "new"),
+		rule(443, "192.168.0.0/16", ""),
+	}
+
+	newRemove, newAdd, update := securityGroupRuleDescriptionChanges(remove, add)
+
+	if len(update) != 1 || update[0].(map[string]interface{})["description"] != "new" {
+		t.Fatalf("expected the port 80 rule to be updated in place, got: %#v", update)
+	}
+	if len(newRemove) != 1 || newRemove[0].(map[string]interface{})["from_port"] != 443 {
+		t.Fatalf("expected the port 443 rule to be revoked, got: %#v", newRemove)
+	}
+	if len(newAdd) != 1 || newAdd[0].(map[string]interface{})["cidr_blocks"].([]interface{})[0] != "192.168.0.0/16" {
+		t.Fatalf("expected the new port 443 rule to be authorized, got: %#v", newAdd)
+	}
+}
+
 func TestAccAWSSecurityGroup_basic(t *testing.T) {
 	var group ec2.SecurityGroup
 
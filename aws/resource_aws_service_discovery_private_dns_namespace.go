@@ -0,0 +1,160 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicediscovery"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsServiceDiscoveryPrivateDnsNamespace() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsServiceDiscoveryPrivateDnsNamespaceCreate,
+		Read:   resourceAwsServiceDiscoveryPrivateDnsNamespaceRead,
+		Delete: resourceAwsServiceDiscoveryPrivateDnsNamespaceDelete,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"vpc": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"hosted_zone": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsServiceDiscoveryPrivateDnsNamespaceCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sdconn
+
+	name := d.Get("name").(string)
+	input := &servicediscovery.CreatePrivateDnsNamespaceInput{
+		Name:             aws.String(name),
+		Vpc:              aws.String(d.Get("vpc").(string)),
+		CreatorRequestId: aws.String(resource.UniqueId()),
+	}
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Service Discovery Private DNS Namespace: %s", input)
+	resp, err := conn.CreatePrivateDnsNamespace(input)
+	if err != nil {
+		return fmt.Errorf("Error creating Service Discovery Private DNS Namespace (%s): %s", name, err)
+	}
+
+	operation, err := waitForServiceDiscoveryOperation(conn, aws.StringValue(resp.OperationId))
+	if err != nil {
+		return fmt.Errorf("Error waiting for Service Discovery Private DNS Namespace (%s) creation: %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(operation.Targets[servicediscovery.OperationTargetTypeNamespace]))
+
+	return resourceAwsServiceDiscoveryPrivateDnsNamespaceRead(d, meta)
+}
+
+func resourceAwsServiceDiscoveryPrivateDnsNamespaceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sdconn
+
+	resp, err := conn.GetNamespace(&servicediscovery.GetNamespaceInput{
+		Id: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, servicediscovery.ErrCodeNamespaceNotFound, "") {
+			log.Printf("[WARN] Service Discovery Private DNS Namespace (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading Service Discovery Private DNS Namespace (%s): %s", d.Id(), err)
+	}
+
+	namespace := resp.Namespace
+
+	d.Set("arn", namespace.Arn)
+	d.Set("description", namespace.Description)
+	d.Set("name", namespace.Name)
+	if namespace.Properties != nil && namespace.Properties.DnsProperties != nil {
+		d.Set("hosted_zone", namespace.Properties.DnsProperties.HostedZoneId)
+	}
+
+	return nil
+}
+
+func resourceAwsServiceDiscoveryPrivateDnsNamespaceDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sdconn
+
+	log.Printf("[DEBUG] Deleting Service Discovery Private DNS Namespace: %s", d.Id())
+	resp, err := conn.DeleteNamespace(&servicediscovery.DeleteNamespaceInput{
+		Id: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, servicediscovery.ErrCodeNamespaceNotFound, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Service Discovery Private DNS Namespace (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitForServiceDiscoveryOperation(conn, aws.StringValue(resp.OperationId)); err != nil {
+		return fmt.Errorf("Error waiting for Service Discovery Private DNS Namespace (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// waitForServiceDiscoveryOperation waits for an asynchronous namespace
+// operation to finish and returns it, so the caller can read its targets.
+func waitForServiceDiscoveryOperation(conn *servicediscovery.ServiceDiscovery, operationId string) (*servicediscovery.Operation, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{servicediscovery.OperationStatusSubmitted, servicediscovery.OperationStatusPending},
+		Target:  []string{servicediscovery.OperationStatusSuccess},
+		Refresh: servicediscoveryOperationRefreshStatusFunc(conn, operationId),
+		Timeout: 5 * time.Minute,
+	}
+
+	operation, err := stateConf.WaitForState()
+	if err != nil {
+		return nil, err
+	}
+
+	return operation.(*servicediscovery.Operation), nil
+}
+
+func servicediscoveryOperationRefreshStatusFunc(conn *servicediscovery.ServiceDiscovery, operationId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := conn.GetOperation(&servicediscovery.GetOperationInput{
+			OperationId: aws.String(operationId),
+		})
+		if err != nil {
+			return nil, "", err
+		}
+
+		operation := resp.Operation
+		status := aws.StringValue(operation.Status)
+		if status == servicediscovery.OperationStatusFail {
+			return operation, status, fmt.Errorf("%s: %s", aws.StringValue(operation.ErrorCode), aws.StringValue(operation.ErrorMessage))
+		}
+
+		return operation, status, nil
+	}
+}
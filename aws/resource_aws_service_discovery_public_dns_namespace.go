@@ -0,0 +1,119 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicediscovery"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsServiceDiscoveryPublicDnsNamespace() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsServiceDiscoveryPublicDnsNamespaceCreate,
+		Read:   resourceAwsServiceDiscoveryPublicDnsNamespaceRead,
+		Delete: resourceAwsServiceDiscoveryPublicDnsNamespaceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"hosted_zone": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsServiceDiscoveryPublicDnsNamespaceCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sdconn
+
+	name := d.Get("name").(string)
+	input := &servicediscovery.CreatePublicDnsNamespaceInput{
+		Name:             aws.String(name),
+		CreatorRequestId: aws.String(resource.UniqueId()),
+	}
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Service Discovery Public DNS Namespace: %s", input)
+	resp, err := conn.CreatePublicDnsNamespace(input)
+	if err != nil {
+		return fmt.Errorf("Error creating Service Discovery Public DNS Namespace (%s): %s", name, err)
+	}
+
+	operation, err := waitForServiceDiscoveryOperation(conn, aws.StringValue(resp.OperationId))
+	if err != nil {
+		return fmt.Errorf("Error waiting for Service Discovery Public DNS Namespace (%s) creation: %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(operation.Targets[servicediscovery.OperationTargetTypeNamespace]))
+
+	return resourceAwsServiceDiscoveryPublicDnsNamespaceRead(d, meta)
+}
+
+func resourceAwsServiceDiscoveryPublicDnsNamespaceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sdconn
+
+	resp, err := conn.GetNamespace(&servicediscovery.GetNamespaceInput{
+		Id: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, servicediscovery.ErrCodeNamespaceNotFound, "") {
+			log.Printf("[WARN] Service Discovery Public DNS Namespace (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading Service Discovery Public DNS Namespace (%s): %s", d.Id(), err)
+	}
+
+	namespace := resp.Namespace
+
+	d.Set("arn", namespace.Arn)
+	d.Set("description", namespace.Description)
+	d.Set("name", namespace.Name)
+	if namespace.Properties != nil && namespace.Properties.DnsProperties != nil {
+		d.Set("hosted_zone", namespace.Properties.DnsProperties.HostedZoneId)
+	}
+
+	return nil
+}
+
+func resourceAwsServiceDiscoveryPublicDnsNamespaceDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sdconn
+
+	log.Printf("[DEBUG] Deleting Service Discovery Public DNS Namespace: %s", d.Id())
+	resp, err := conn.DeleteNamespace(&servicediscovery.DeleteNamespaceInput{
+		Id: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, servicediscovery.ErrCodeNamespaceNotFound, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Service Discovery Public DNS Namespace (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitForServiceDiscoveryOperation(conn, aws.StringValue(resp.OperationId)); err != nil {
+		return fmt.Errorf("Error waiting for Service Discovery Public DNS Namespace (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,288 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicediscovery"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsServiceDiscoveryService() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsServiceDiscoveryServiceCreate,
+		Read:   resourceAwsServiceDiscoveryServiceRead,
+		Update: resourceAwsServiceDiscoveryServiceUpdate,
+		Delete: resourceAwsServiceDiscoveryServiceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"dns_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"namespace_id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"dns_records": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"ttl": {
+										Type:     schema.TypeInt,
+										Required: true,
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											servicediscovery.RecordTypeSrv,
+											servicediscovery.RecordTypeA,
+											servicediscovery.RecordTypeAaaa,
+										}, false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"health_check_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"failure_threshold": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 10),
+						},
+						"resource_path": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								servicediscovery.HealthCheckTypeHttp,
+								servicediscovery.HealthCheckTypeHttps,
+								servicediscovery.HealthCheckTypeTcp,
+							}, false),
+						},
+					},
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsServiceDiscoveryServiceCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sdconn
+
+	input := &servicediscovery.CreateServiceInput{
+		Name:             aws.String(d.Get("name").(string)),
+		DnsConfig:        expandServiceDiscoveryDnsConfig(d.Get("dns_config").([]interface{})),
+		CreatorRequestId: aws.String(resource.UniqueId()),
+	}
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("health_check_config"); ok {
+		input.HealthCheckConfig = expandServiceDiscoveryHealthCheckConfig(v.([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating Service Discovery Service: %s", input)
+	resp, err := conn.CreateService(input)
+	if err != nil {
+		return fmt.Errorf("Error creating Service Discovery Service: %s", err)
+	}
+
+	d.SetId(aws.StringValue(resp.Service.Id))
+
+	return resourceAwsServiceDiscoveryServiceRead(d, meta)
+}
+
+func resourceAwsServiceDiscoveryServiceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sdconn
+
+	resp, err := conn.GetService(&servicediscovery.GetServiceInput{
+		Id: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, servicediscovery.ErrCodeServiceNotFound, "") {
+			log.Printf("[WARN] Service Discovery Service (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading Service Discovery Service (%s): %s", d.Id(), err)
+	}
+
+	service := resp.Service
+
+	d.Set("arn", service.Arn)
+	d.Set("description", service.Description)
+	d.Set("name", service.Name)
+	if err := d.Set("dns_config", flattenServiceDiscoveryDnsConfig(service.DnsConfig)); err != nil {
+		return fmt.Errorf("Error setting dns_config: %s", err)
+	}
+	if err := d.Set("health_check_config", flattenServiceDiscoveryHealthCheckConfig(service.HealthCheckConfig)); err != nil {
+		return fmt.Errorf("Error setting health_check_config: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsServiceDiscoveryServiceUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sdconn
+
+	// UpdateService replaces the whole service, so the unchanged settings
+	// are sent along with the changed ones.
+	dnsConfig := expandServiceDiscoveryDnsConfig(d.Get("dns_config").([]interface{}))
+	input := &servicediscovery.UpdateServiceInput{
+		Id: aws.String(d.Id()),
+		Service: &servicediscovery.ServiceChange{
+			Description: aws.String(d.Get("description").(string)),
+			DnsConfig: &servicediscovery.DnsConfigChange{
+				DnsRecords: dnsConfig.DnsRecords,
+			},
+		},
+	}
+	if v, ok := d.GetOk("health_check_config"); ok {
+		input.Service.HealthCheckConfig = expandServiceDiscoveryHealthCheckConfig(v.([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Updating Service Discovery Service: %s", input)
+	resp, err := conn.UpdateService(input)
+	if err != nil {
+		return fmt.Errorf("Error updating Service Discovery Service (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitForServiceDiscoveryOperation(conn, aws.StringValue(resp.OperationId)); err != nil {
+		return fmt.Errorf("Error waiting for Service Discovery Service (%s) update: %s", d.Id(), err)
+	}
+
+	return resourceAwsServiceDiscoveryServiceRead(d, meta)
+}
+
+func resourceAwsServiceDiscoveryServiceDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sdconn
+
+	log.Printf("[DEBUG] Deleting Service Discovery Service: %s", d.Id())
+	_, err := conn.DeleteService(&servicediscovery.DeleteServiceInput{
+		Id: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, servicediscovery.ErrCodeServiceNotFound, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Service Discovery Service (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandServiceDiscoveryDnsConfig(configured []interface{}) *servicediscovery.DnsConfig {
+	config := configured[0].(map[string]interface{})
+
+	records := make([]*servicediscovery.DnsRecord, 0)
+	for _, r := range config["dns_records"].([]interface{}) {
+		record := r.(map[string]interface{})
+		records = append(records, &servicediscovery.DnsRecord{
+			TTL:  aws.Int64(int64(record["ttl"].(int))),
+			Type: aws.String(record["type"].(string)),
+		})
+	}
+
+	return &servicediscovery.DnsConfig{
+		NamespaceId: aws.String(config["namespace_id"].(string)),
+		DnsRecords:  records,
+	}
+}
+
+func flattenServiceDiscoveryDnsConfig(config *servicediscovery.DnsConfig) []map[string]interface{} {
+	if config == nil {
+		return nil
+	}
+
+	records := make([]map[string]interface{}, 0, len(config.DnsRecords))
+	for _, record := range config.DnsRecords {
+		records = append(records, map[string]interface{}{
+			"ttl":  aws.Int64Value(record.TTL),
+			"type": aws.StringValue(record.Type),
+		})
+	}
+
+	return []map[string]interface{}{
+		{
+			"namespace_id": aws.StringValue(config.NamespaceId),
+			"dns_records":  records,
+		},
+	}
+}
+
+func expandServiceDiscoveryHealthCheckConfig(configured []interface{}) *servicediscovery.HealthCheckConfig {
+	if len(configured) == 0 || configured[0] == nil {
+		return nil
+	}
+	config := configured[0].(map[string]interface{})
+
+	healthCheckConfig := &servicediscovery.HealthCheckConfig{}
+	if v, ok := config["failure_threshold"].(int); ok && v != 0 {
+		healthCheckConfig.FailureThreshold = aws.Int64(int64(v))
+	}
+	if v, ok := config["resource_path"].(string); ok && v != "" {
+		healthCheckConfig.ResourcePath = aws.String(v)
+	}
+	if v, ok := config["type"].(string); ok && v != "" {
+		healthCheckConfig.Type = aws.String(v)
+	}
+
+	return healthCheckConfig
+}
+
+func flattenServiceDiscoveryHealthCheckConfig(config *servicediscovery.HealthCheckConfig) []map[string]interface{} {
+	if config == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{}
+	if config.FailureThreshold != nil {
+		m["failure_threshold"] = aws.Int64Value(config.FailureThreshold)
+	}
+	if config.ResourcePath != nil {
+		m["resource_path"] = aws.StringValue(config.ResourcePath)
+	}
+	if config.Type != nil {
+		m["type"] = aws.StringValue(config.Type)
+	}
+
+	return []map[string]interface{}{m}
+}
@@ -13,6 +13,7 @@ func resourceAwsSesConfigurationSet() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsSesConfigurationSetCreate,
 		Read:   resourceAwsSesConfigurationSetRead,
+		Update: resourceAwsSesConfigurationSetUpdate,
 		Delete: resourceAwsSesConfigurationSetDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
@@ -24,6 +25,11 @@ func resourceAwsSesConfigurationSet() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"reputation_metrics_enabled": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 	}
 }
@@ -46,27 +52,56 @@ func resourceAwsSesConfigurationSetCreate(d *schema.ResourceData, meta interface
 
 	d.SetId(configurationSetName)
 
+	if d.Get("reputation_metrics_enabled").(bool) {
+		if err := updateSesConfigurationSetReputationMetricsEnabled(conn, d); err != nil {
+			return err
+		}
+	}
+
 	return resourceAwsSesConfigurationSetRead(d, meta)
 }
 
 func resourceAwsSesConfigurationSetRead(d *schema.ResourceData, meta interface{}) error {
-	configurationSetExists, err := findConfigurationSet(d.Id(), nil, meta)
-
-	if !configurationSetExists {
-		log.Printf("[WARN] SES Configuration Set (%s) not found", d.Id())
-		d.SetId("")
-		return nil
-	}
+	conn := meta.(*AWSClient).sesConn
 
+	response, err := conn.DescribeConfigurationSet(&ses.DescribeConfigurationSetInput{
+		ConfigurationSetName: aws.String(d.Id()),
+		ConfigurationSetAttributeNames: aws.StringSlice([]string{
+			ses.ConfigurationSetAttributeReputationOptions,
+		}),
+	})
 	if err != nil {
-		return err
+		if isAWSErr(err, ses.ErrCodeConfigurationSetDoesNotExistException, "") {
+			log.Printf("[WARN] SES Configuration Set (%s) not found", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading SES configuration set (%s): %s", d.Id(), err)
 	}
 
-	d.Set("name", d.Id())
+	d.Set("name", response.ConfigurationSet.Name)
+
+	reputationMetricsEnabled := false
+	if response.ReputationOptions != nil {
+		reputationMetricsEnabled = aws.BoolValue(response.ReputationOptions.ReputationMetricsEnabled)
+	}
+	d.Set("reputation_metrics_enabled", reputationMetricsEnabled)
 
 	return nil
 }
 
+func resourceAwsSesConfigurationSetUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sesConn
+
+	if d.HasChange("reputation_metrics_enabled") {
+		if err := updateSesConfigurationSetReputationMetricsEnabled(conn, d); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsSesConfigurationSetRead(d, meta)
+}
+
 func resourceAwsSesConfigurationSetDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).sesConn
 
@@ -82,29 +117,17 @@ func resourceAwsSesConfigurationSetDelete(d *schema.ResourceData, meta interface
 	return nil
 }
 
-func findConfigurationSet(name string, token *string, meta interface{}) (bool, error) {
-	conn := meta.(*AWSClient).sesConn
-
-	configurationSetExists := false
-
-	listOpts := &ses.ListConfigurationSetsInput{
-		NextToken: token,
-	}
-
-	response, err := conn.ListConfigurationSets(listOpts)
-	for _, element := range response.ConfigurationSets {
-		if *element.Name == name {
-			configurationSetExists = true
-		}
-	}
-
-	if err != nil && !configurationSetExists && response.NextToken != nil {
-		configurationSetExists, err = findConfigurationSet(name, response.NextToken, meta)
+func updateSesConfigurationSetReputationMetricsEnabled(conn *ses.SES, d *schema.ResourceData) error {
+	input := &ses.UpdateConfigurationSetReputationMetricsEnabledInput{
+		ConfigurationSetName: aws.String(d.Id()),
+		Enabled:              aws.Bool(d.Get("reputation_metrics_enabled").(bool)),
 	}
 
+	log.Printf("[DEBUG] Updating SES configuration set reputation metrics: %s", input)
+	_, err := conn.UpdateConfigurationSetReputationMetricsEnabled(input)
 	if err != nil {
-		return false, err
+		return fmt.Errorf("Error updating SES configuration set (%s) reputation metrics: %s", d.Id(), err)
 	}
 
-	return configurationSetExists, nil
+	return nil
 }
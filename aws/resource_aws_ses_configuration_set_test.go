@@ -22,12 +22,44 @@ func TestAccAWSSESConfigurationSet_basic(t *testing.T) {
 				Config: testAccAWSSESConfigurationSetConfig,
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAwsSESConfigurationSetExists("aws_ses_configuration_set.test"),
+					resource.TestCheckResourceAttr("aws_ses_configuration_set.test", "reputation_metrics_enabled", "false"),
 				),
 			},
 		},
 	})
 }
 
+func TestAccAWSSESConfigurationSet_reputationMetricsEnabled(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSESConfigurationSetDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSSESConfigurationSetConfig_reputationMetricsEnabled(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSESConfigurationSetExists("aws_ses_configuration_set.test"),
+					resource.TestCheckResourceAttr("aws_ses_configuration_set.test", "reputation_metrics_enabled", "true"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccAWSSESConfigurationSetConfig_reputationMetricsEnabled(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSESConfigurationSetExists("aws_ses_configuration_set.test"),
+					resource.TestCheckResourceAttr("aws_ses_configuration_set.test", "reputation_metrics_enabled", "false"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "aws_ses_configuration_set.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckSESConfigurationSetDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).sesConn
 
@@ -97,3 +129,12 @@ resource "aws_ses_configuration_set" "test" {
     name = "some-configuration-set-%d"
 }
 `, escRandomInteger)
+
+func testAccAWSSESConfigurationSetConfig_reputationMetricsEnabled(enabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_ses_configuration_set" "test" {
+  name                       = "some-configuration-set-%d"
+  reputation_metrics_enabled = %t
+}
+`, escRandomInteger, enabled)
+}
@@ -77,7 +77,7 @@ func resourceAwsSesDomainDkimRead(d *schema.ResourceData, meta interface{}) erro
 		return nil
 	}
 
-	d.Set("dkim_tokens", aws.StringValueSlice(verificationAttrs.DkimTokens))
+	d.Set("dkim_tokens", sesDkimTokens(verificationAttrs.DkimTokens))
 	return nil
 }
 
@@ -0,0 +1,110 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ses"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ses/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ses/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsSesDomainIdentityVerification() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSesDomainIdentityVerificationCreate,
+		Read:   resourceAwsSesDomainIdentityVerificationRead,
+		Delete: resourceAwsSesDomainIdentityVerificationDelete,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"domain": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"dkim_tokens": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(waiter.IdentityVerifiedTimeout),
+		},
+	}
+}
+
+func resourceAwsSesDomainIdentityVerificationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sesConn
+	domainName := d.Get("domain").(string)
+
+	log.Printf("[DEBUG] Waiting for SES domain identity (%s) to be verified", domainName)
+	if _, err := waiter.IdentityVerified(conn, domainName, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("Error waiting for SES domain identity (%s) to be verified: %s", domainName, err)
+	}
+
+	d.SetId(domainName)
+
+	return resourceAwsSesDomainIdentityVerificationRead(d, meta)
+}
+
+func resourceAwsSesDomainIdentityVerificationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sesConn
+
+	attrs, err := finder.IdentityVerificationAttributesByIdentity(conn, d.Id())
+	if tfresource.NotFound(err) {
+		log.Printf("[WARN] SES domain identity (%s) not found, removing verification from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error reading SES domain identity (%s) verification attributes: %s", d.Id(), err)
+	}
+
+	if status := aws.StringValue(attrs.VerificationStatus); status != ses.VerificationStatusSuccess {
+		log.Printf("[WARN] SES domain identity (%s) verification status is %s, removing verification from state", d.Id(), status)
+		d.SetId("")
+		return nil
+	}
+
+	dkimAttrs, err := finder.IdentityDkimAttributesByIdentity(conn, d.Id())
+	if err != nil && !tfresource.NotFound(err) {
+		return fmt.Errorf("Error reading SES domain identity (%s) DKIM attributes: %s", d.Id(), err)
+	}
+
+	var dkimTokens []string
+	if dkimAttrs != nil {
+		dkimTokens = sesDkimTokens(dkimAttrs.DkimTokens)
+	}
+
+	client := meta.(*AWSClient)
+	d.Set("arn", fmt.Sprintf("arn:%s:ses:%s:%s:identity/%s", client.partition, client.region, client.accountid, d.Id()))
+	d.Set("domain", d.Id())
+	if err := d.Set("dkim_tokens", dkimTokens); err != nil {
+		return fmt.Errorf("Error setting dkim_tokens: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsSesDomainIdentityVerificationDelete(d *schema.ResourceData, meta interface{}) error {
+	// Verification cannot be undone, there is nothing to delete.
+	log.Printf("[DEBUG] Removing SES domain identity (%s) verification from state", d.Id())
+	return nil
+}
+
+// sesDkimTokens returns the DKIM tokens sorted, as SES does not return them
+// in a consistent order and records built from them would otherwise churn.
+func sesDkimTokens(tokens []*string) []string {
+	result := aws.StringValueSlice(tokens)
+	sort.Strings(result)
+	return result
+}
@@ -0,0 +1,81 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestSesDkimTokens(t *testing.T) {
+	tokens := []*string{aws.String("ccc"), aws.String("aaa"), aws.String("bbb")}
+
+	expected := []string{"aaa", "bbb", "ccc"}
+	if actual := sesDkimTokens(tokens); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %q, got %q", expected, actual)
+	}
+}
+
+func TestAccAwsSesDomainIdentityVerification_basic(t *testing.T) {
+	rootDomain := os.Getenv("SES_DOMAIN_IDENTITY_ROOT_DOMAIN")
+	if rootDomain == "" {
+		t.Skip(
+			"Environment variable SES_DOMAIN_IDENTITY_ROOT_DOMAIN is not set. " +
+				"For DNS verification requests, this domain must be publicly " +
+				"accessible and configurable via Route53 during the testing. ")
+	}
+
+	domain := fmt.Sprintf("tf-acc-%d.%s", acctest.RandInt(), rootDomain)
+	resourceName := "aws_ses_domain_identity_verification.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSESDomainIdentityDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsSesDomainIdentityVerificationConfig(rootDomain, domain),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "domain", domain),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "dkim_tokens.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAwsSesDomainIdentityVerificationConfig(rootDomain, domain string) string {
+	return fmt.Sprintf(`
+data "aws_route53_zone" "test" {
+  name         = "%s."
+  private_zone = false
+}
+
+resource "aws_ses_domain_identity" "test" {
+  domain = "%s"
+}
+
+resource "aws_ses_domain_dkim" "test" {
+  domain = "${aws_ses_domain_identity.test.domain}"
+}
+
+resource "aws_route53_record" "domain_identity_verification" {
+  zone_id = "${data.aws_route53_zone.test.id}"
+  name    = "_amazonses.${aws_ses_domain_identity.test.domain}"
+  type    = "TXT"
+  ttl     = "600"
+  records = ["${aws_ses_domain_identity.test.verification_token}"]
+}
+
+resource "aws_ses_domain_identity_verification" "test" {
+  domain = "${aws_ses_domain_identity.test.domain}"
+
+  depends_on = ["aws_route53_record.domain_identity_verification", "aws_ses_domain_dkim.test"]
+}
+`, rootDomain, domain)
+}
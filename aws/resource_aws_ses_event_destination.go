@@ -148,7 +148,34 @@ func resourceAwsSesEventDestinationCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsSesEventDestinationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sesConn
+
+	configurationSetName := d.Get("configuration_set_name").(string)
+	if configurationSetName == "" {
+		return nil
+	}
+
+	out, err := conn.DescribeConfigurationSet(&ses.DescribeConfigurationSetInput{
+		ConfigurationSetName:           aws.String(configurationSetName),
+		ConfigurationSetAttributeNames: aws.StringSlice([]string{ses.ConfigurationSetAttributeEventDestinations}),
+	})
+	if err != nil {
+		if isAWSErr(err, ses.ErrCodeConfigurationSetDoesNotExistException, "") {
+			log.Printf("[WARN] SES Configuration Set (%s) not found, removing event destination %s from state", configurationSetName, d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading SES Configuration Set (%s): %s", configurationSetName, err)
+	}
+
+	for _, destination := range out.EventDestinations {
+		if aws.StringValue(destination.Name) == d.Id() {
+			return nil
+		}
+	}
 
+	log.Printf("[WARN] SES Event Destination (%s) not found, removing from state", d.Id())
+	d.SetId("")
 	return nil
 }
 
@@ -25,9 +25,10 @@ func resourceAwsSfnStateMachine() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"definition": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateSfnStateMachineDefinition,
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validateSfnStateMachineDefinition,
+				DiffSuppressFunc: suppressEquivalentJsonDiffs,
 			},
 
 			"name": {
@@ -0,0 +1,278 @@
+package aws
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// SNSPlatformApplicationAttributeMap maps the mutable schema keys of
+// aws_sns_platform_application to the attribute names used by the SNS API.
+// The credentials are handled separately since they are never returned.
+var SNSPlatformApplicationAttributeMap = map[string]string{
+	"event_delivery_failure_topic_arn": "EventDeliveryFailure",
+	"event_endpoint_created_topic_arn": "EventEndpointCreated",
+	"event_endpoint_deleted_topic_arn": "EventEndpointDeleted",
+	"event_endpoint_updated_topic_arn": "EventEndpointUpdated",
+	"failure_feedback_role_arn":        "FailureFeedbackRoleArn",
+	"success_feedback_role_arn":        "SuccessFeedbackRoleArn",
+	"success_feedback_sample_rate":     "SuccessFeedbackSampleRate",
+}
+
+func resourceAwsSnsPlatformApplication() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSnsPlatformApplicationCreate,
+		Read:   resourceAwsSnsPlatformApplicationRead,
+		Update: resourceAwsSnsPlatformApplicationUpdate,
+		Delete: resourceAwsSnsPlatformApplicationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"platform": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"ADM",
+					"APNS",
+					"APNS_SANDBOX",
+					"BAIDU",
+					"GCM",
+					"MPNS",
+					"WNS",
+				}, false),
+			},
+			"platform_credential": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+				StateFunc: hashSnsPlatformApplicationCredential,
+			},
+			"platform_principal": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+				StateFunc: hashSnsPlatformApplicationCredential,
+			},
+			"event_delivery_failure_topic_arn": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"event_endpoint_created_topic_arn": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"event_endpoint_deleted_topic_arn": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"event_endpoint_updated_topic_arn": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"failure_feedback_role_arn": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"success_feedback_role_arn": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"success_feedback_sample_rate": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsSnsPlatformApplicationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).snsconn
+
+	attributes := map[string]*string{
+		"PlatformCredential": aws.String(d.Get("platform_credential").(string)),
+	}
+	if v, ok := d.GetOk("platform_principal"); ok {
+		attributes["PlatformPrincipal"] = aws.String(v.(string))
+	}
+
+	input := &sns.CreatePlatformApplicationInput{
+		Name:       aws.String(d.Get("name").(string)),
+		Platform:   aws.String(d.Get("platform").(string)),
+		Attributes: attributes,
+	}
+
+	log.Printf("[DEBUG] Creating SNS Platform Application: %s", d.Get("name").(string))
+	output, err := conn.CreatePlatformApplication(input)
+	if err != nil {
+		return fmt.Errorf("Error creating SNS Platform Application: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.PlatformApplicationArn))
+
+	if err := setSnsPlatformApplicationAttributes(conn, d, snsPlatformApplicationAttributes(d, true)); err != nil {
+		return err
+	}
+
+	return resourceAwsSnsPlatformApplicationRead(d, meta)
+}
+
+func resourceAwsSnsPlatformApplicationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).snsconn
+
+	attributes := snsPlatformApplicationAttributes(d, false)
+
+	// The credential and principal are rotated together, since the API
+	// requires both to be set when updating APNS credentials.
+	if d.HasChange("platform_credential") || d.HasChange("platform_principal") {
+		attributes["PlatformCredential"] = aws.String(d.Get("platform_credential").(string))
+		if v, ok := d.GetOk("platform_principal"); ok {
+			attributes["PlatformPrincipal"] = aws.String(v.(string))
+		}
+	}
+
+	if err := setSnsPlatformApplicationAttributes(conn, d, attributes); err != nil {
+		return err
+	}
+
+	return resourceAwsSnsPlatformApplicationRead(d, meta)
+}
+
+func resourceAwsSnsPlatformApplicationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).snsconn
+
+	output, err := conn.GetPlatformApplicationAttributes(&sns.GetPlatformApplicationAttributesInput{
+		PlatformApplicationArn: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, sns.ErrCodeNotFoundException, "") {
+			log.Printf("[WARN] SNS Platform Application (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading SNS Platform Application (%s): %s", d.Id(), err)
+	}
+
+	name, platform, err := decodeSnsPlatformApplicationArn(d.Id())
+	if err != nil {
+		return err
+	}
+
+	d.Set("arn", d.Id())
+	d.Set("name", name)
+	d.Set("platform", platform)
+
+	for key, name := range SNSPlatformApplicationAttributeMap {
+		d.Set(key, aws.StringValue(output.Attributes[name]))
+	}
+
+	return nil
+}
+
+func resourceAwsSnsPlatformApplicationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).snsconn
+
+	log.Printf("[DEBUG] Deleting SNS Platform Application: %s", d.Id())
+	_, err := conn.DeletePlatformApplication(&sns.DeletePlatformApplicationInput{
+		PlatformApplicationArn: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, sns.ErrCodeNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting SNS Platform Application (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// snsPlatformApplicationAttributes returns the mutable attributes to set,
+// either all those configured or only those which have changed.
+func snsPlatformApplicationAttributes(d *schema.ResourceData, isNew bool) map[string]*string {
+	attributes := make(map[string]*string)
+	for key, name := range SNSPlatformApplicationAttributeMap {
+		if isNew {
+			if v, ok := d.GetOk(key); ok {
+				attributes[name] = aws.String(v.(string))
+			}
+		} else if d.HasChange(key) {
+			attributes[name] = aws.String(d.Get(key).(string))
+		}
+	}
+	return attributes
+}
+
+func setSnsPlatformApplicationAttributes(conn *sns.SNS, d *schema.ResourceData, attributes map[string]*string) error {
+	if len(attributes) == 0 {
+		return nil
+	}
+
+	// IAM roles and topics used for feedback take some time to propagate.
+	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+		_, err := conn.SetPlatformApplicationAttributes(&sns.SetPlatformApplicationAttributesInput{
+			PlatformApplicationArn: aws.String(d.Id()),
+			Attributes:             attributes,
+		})
+		if err != nil {
+			if isAWSErr(err, sns.ErrCodeInvalidParameterException, "is not a valid role") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error setting SNS Platform Application (%s) attributes: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// decodeSnsPlatformApplicationArn returns the name and platform of the
+// platform application with an ARN of the form
+// arn:aws:sns:region:account:app/PLATFORM/name.
+func decodeSnsPlatformApplicationArn(applicationArn string) (string, string, error) {
+	parsed, err := arn.Parse(applicationArn)
+	if err != nil {
+		return "", "", fmt.Errorf("Error parsing SNS Platform Application ARN (%s): %s", applicationArn, err)
+	}
+
+	parts := strings.Split(parsed.Resource, "/")
+	if len(parts) != 3 || parts[0] != "app" {
+		return "", "", fmt.Errorf("Unexpected format of SNS Platform Application ARN (%s), expected arn:PARTITION:sns:REGION:ACCOUNT:app/PLATFORM/NAME", applicationArn)
+	}
+
+	return parts[2], parts[1], nil
+}
+
+// hashSnsPlatformApplicationCredential stores the credentials as a hash, as
+// they are never returned by the API and should not be kept in plain text.
+func hashSnsPlatformApplicationCredential(v interface{}) string {
+	s, ok := v.(string)
+	if !ok || s == "" {
+		return ""
+	}
+	hash := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(hash[:])
+}
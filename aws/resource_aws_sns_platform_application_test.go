@@ -0,0 +1,141 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestDecodeSnsPlatformApplicationArn(t *testing.T) {
+	name, platform, err := decodeSnsPlatformApplicationArn("arn:aws:sns:us-west-2:123456789012:app/GCM/gcm_application")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name != "gcm_application" || platform != "GCM" {
+		t.Fatalf("unexpected name %q and platform %q", name, platform)
+	}
+
+	for _, v := range []string{
+		"gcm_application",
+		"arn:aws:sns:us-west-2:123456789012:my-topic",
+		"arn:aws:sns:us-west-2:123456789012:endpoint/GCM/gcm_application/1234",
+	} {
+		if _, _, err := decodeSnsPlatformApplicationArn(v); err == nil {
+			t.Fatalf("expected %q to be an invalid SNS Platform Application ARN", v)
+		}
+	}
+}
+
+func TestHashSnsPlatformApplicationCredential(t *testing.T) {
+	if v := hashSnsPlatformApplicationCredential(""); v != "" {
+		t.Fatalf("expected an empty credential to hash to an empty string, got %q", v)
+	}
+	if v := hashSnsPlatformApplicationCredential("secret"); v == "secret" || len(v) != 64 {
+		t.Fatalf("expected a hex encoded SHA-256 hash, got %q", v)
+	}
+}
+
+func testAccAwsSnsPlatformApplicationGcmApiKey(t *testing.T) string {
+	key := os.Getenv("SNS_PLATFORM_APPLICATION_GCM_API_KEY")
+	if key == "" {
+		t.Skip("Environment variable SNS_PLATFORM_APPLICATION_GCM_API_KEY is not set")
+	}
+	return key
+}
+
+func TestAccAWSSnsPlatformApplication_gcm(t *testing.T) {
+	apiKey := testAccAwsSnsPlatformApplicationGcmApiKey(t)
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_sns_platform_application.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSNSPlatformApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsSnsPlatformApplicationConfigGcm(rName, apiKey, "50"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSnsPlatformApplicationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "platform", "GCM"),
+					resource.TestCheckResourceAttr(resourceName, "platform_credential", hashSnsPlatformApplicationCredential(apiKey)),
+					resource.TestCheckResourceAttr(resourceName, "success_feedback_sample_rate", "50"),
+				),
+			},
+			{
+				Config: testAccAwsSnsPlatformApplicationConfigGcm(rName, apiKey, "100"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSnsPlatformApplicationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "success_feedback_sample_rate", "100"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"platform_credential", "platform_principal"},
+			},
+		},
+	})
+}
+
+func testAccCheckAwsSnsPlatformApplicationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SNS Platform Application ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).snsconn
+		_, err := conn.GetPlatformApplicationAttributes(&sns.GetPlatformApplicationAttributesInput{
+			PlatformApplicationArn: aws.String(rs.Primary.ID),
+		})
+		return err
+	}
+}
+
+func testAccCheckAWSSNSPlatformApplicationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).snsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_sns_platform_application" {
+			continue
+		}
+
+		_, err := conn.GetPlatformApplicationAttributes(&sns.GetPlatformApplicationAttributesInput{
+			PlatformApplicationArn: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			if isAWSErr(err, sns.ErrCodeNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		return fmt.Errorf("SNS Platform Application (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAwsSnsPlatformApplicationConfigGcm(rName, apiKey, sampleRate string) string {
+	return fmt.Sprintf(`
+resource "aws_sns_platform_application" "test" {
+  name                         = %q
+  platform                     = "GCM"
+  platform_credential          = %q
+  success_feedback_sample_rate = %q
+}
+`, rName, apiKey, sampleRate)
+}
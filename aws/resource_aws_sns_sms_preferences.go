@@ -0,0 +1,159 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// SNSSMSAttributeMap maps the schema keys of aws_sns_sms_preferences to
+// the SMS attribute names used by the SNS API.
+var SNSSMSAttributeMap = map[string]string{
+	"monthly_spend_limit":                   "MonthlySpendLimit",
+	"delivery_status_iam_role_arn":          "DeliveryStatusIAMRole",
+	"delivery_status_success_sampling_rate": "DeliveryStatusSuccessSamplingRate",
+	"default_sender_id":                     "DefaultSenderID",
+	"default_sms_type":                      "DefaultSMSType",
+	"usage_report_s3_bucket":                "UsageReportS3Bucket",
+}
+
+func resourceAwsSnsSmsPreferences() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSnsSmsPreferencesSet,
+		Read:   resourceAwsSnsSmsPreferencesGet,
+		Update: resourceAwsSnsSmsPreferencesSet,
+		Delete: resourceAwsSnsSmsPreferencesDelete,
+
+		Schema: map[string]*schema.Schema{
+			"monthly_spend_limit": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateSnsSmsPreferencesInteger(0, -1),
+			},
+			"delivery_status_iam_role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArn,
+			},
+			"delivery_status_success_sampling_rate": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateSnsSmsPreferencesInteger(0, 100),
+			},
+			"default_sender_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"default_sms_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"Promotional", "Transactional"}, false),
+			},
+			"usage_report_s3_bucket": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceAwsSnsSmsPreferencesSet(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).snsconn
+
+	// Only send the attributes that were configured or removed from the
+	// configuration, so preferences managed outside of Terraform are kept.
+	attributes := make(map[string]*string)
+	for key, name := range SNSSMSAttributeMap {
+		if d.HasChange(key) {
+			attributes[name] = aws.String(d.Get(key).(string))
+		}
+	}
+
+	if len(attributes) > 0 {
+		log.Printf("[DEBUG] Setting SNS SMS preferences")
+		_, err := conn.SetSMSAttributes(&sns.SetSMSAttributesInput{
+			Attributes: attributes,
+		})
+		if err != nil {
+			return fmt.Errorf("Error setting SNS SMS preferences: %s", err)
+		}
+	}
+
+	// The SMS preferences are an account-level singleton.
+	d.SetId("aws_sns_sms_preferences")
+
+	return resourceAwsSnsSmsPreferencesGet(d, meta)
+}
+
+func resourceAwsSnsSmsPreferencesGet(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).snsconn
+
+	output, err := conn.GetSMSAttributes(&sns.GetSMSAttributesInput{})
+	if err != nil {
+		return fmt.Errorf("Error reading SNS SMS preferences: %s", err)
+	}
+
+	// Attributes not managed by Terraform are left out of the state.
+	for key, name := range SNSSMSAttributeMap {
+		if _, ok := d.GetOk(key); ok {
+			d.Set(key, aws.StringValue(output.Attributes[name]))
+		}
+	}
+
+	return nil
+}
+
+func resourceAwsSnsSmsPreferencesDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).snsconn
+
+	// Reset the managed preferences to their defaults.
+	attributes := make(map[string]*string)
+	for key, name := range SNSSMSAttributeMap {
+		if _, ok := d.GetOk(key); ok {
+			attributes[name] = aws.String("")
+		}
+	}
+	if len(attributes) == 0 {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Resetting SNS SMS preferences")
+	_, err := conn.SetSMSAttributes(&sns.SetSMSAttributesInput{
+		Attributes: attributes,
+	})
+	if err != nil {
+		return fmt.Errorf("Error resetting SNS SMS preferences: %s", err)
+	}
+
+	return nil
+}
+
+// validateSnsSmsPreferencesInteger validates that a string attribute holds an
+// integer of at least min and, unless max is negative, at most max.
+func validateSnsSmsPreferencesInteger(min, max int) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		value := v.(string)
+		if value == "" {
+			return
+		}
+
+		i, err := strconv.Atoi(value)
+		if err != nil {
+			errors = append(errors, fmt.Errorf("%q must be an integer, got: %q", k, value))
+			return
+		}
+		if i < min || (max >= 0 && i > max) {
+			if max >= 0 {
+				errors = append(errors, fmt.Errorf("%q must be between %d and %d, got: %d", k, min, max, i))
+			} else {
+				errors = append(errors, fmt.Errorf("%q must be at least %d, got: %d", k, min, i))
+			}
+		}
+		return
+	}
+}
@@ -0,0 +1,101 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestValidateSnsSmsPreferencesInteger(t *testing.T) {
+	validRate := validateSnsSmsPreferencesInteger(0, 100)
+	for _, v := range []string{"", "0", "50", "100"} {
+		if _, errors := validRate(v, "delivery_status_success_sampling_rate"); len(errors) != 0 {
+			t.Fatalf("%q should be a valid sampling rate: %q", v, errors)
+		}
+	}
+	for _, v := range []string{"-1", "101", "ten"} {
+		if _, errors := validRate(v, "delivery_status_success_sampling_rate"); len(errors) == 0 {
+			t.Fatalf("%q should be an invalid sampling rate", v)
+		}
+	}
+
+	validLimit := validateSnsSmsPreferencesInteger(0, -1)
+	if _, errors := validLimit("1000000", "monthly_spend_limit"); len(errors) != 0 {
+		t.Fatalf("expected an unbounded spend limit to be valid: %q", errors)
+	}
+}
+
+// The SMS preferences are account-wide, so these tests must not run in parallel.
+func TestAccAWSSNSSMSPreferences_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSNSSMSPreferencesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSNSSMSPreferencesConfig("Transactional"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_sns_sms_preferences.test", "monthly_spend_limit", "1"),
+					resource.TestCheckResourceAttr("aws_sns_sms_preferences.test", "default_sender_id", "TfAccTest"),
+					resource.TestCheckResourceAttr("aws_sns_sms_preferences.test", "default_sms_type", "Transactional"),
+				),
+			},
+			{
+				Config: testAccAWSSNSSMSPreferencesConfig("Promotional"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_sns_sms_preferences.test", "default_sms_type", "Promotional"),
+				),
+			},
+			{
+				Config: testAccAWSSNSSMSPreferencesConfigSmsTypeOnly,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_sns_sms_preferences.test", "default_sender_id", ""),
+					resource.TestCheckResourceAttr("aws_sns_sms_preferences.test", "default_sms_type", "Promotional"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSSNSSMSPreferencesDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).snsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_sns_sms_preferences" {
+			continue
+		}
+
+		output, err := conn.GetSMSAttributes(&sns.GetSMSAttributesInput{})
+		if err != nil {
+			return err
+		}
+
+		for _, name := range []string{"DefaultSenderID", "DefaultSMSType"} {
+			if v := aws.StringValue(output.Attributes[name]); v != "" {
+				return fmt.Errorf("SNS SMS preference %s was not reset: %s", name, v)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSSNSSMSPreferencesConfig(smsType string) string {
+	return fmt.Sprintf(`
+resource "aws_sns_sms_preferences" "test" {
+  monthly_spend_limit = "1"
+  default_sender_id   = "TfAccTest"
+  default_sms_type    = %q
+}
+`, smsType)
+}
+
+const testAccAWSSNSSMSPreferencesConfigSmsTypeOnly = `
+resource "aws_sns_sms_preferences" "test" {
+  default_sms_type = "Promotional"
+}
+`
@@ -47,10 +47,7 @@ func resourceAwsSnsTopic() *schema.Resource {
 				Computed:         true,
 				ValidateFunc:     validateJsonString,
 				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
-				StateFunc: func(v interface{}) string {
-					json, _ := normalizeJsonString(v)
-					return json
-				},
+				StateFunc:        normalizeJsonStateFunc,
 			},
 			"delivery_policy": &schema.Schema{
 				Type:             schema.TypeString,
@@ -58,10 +55,7 @@ func resourceAwsSnsTopic() *schema.Resource {
 				ForceNew:         false,
 				ValidateFunc:     validateJsonString,
 				DiffSuppressFunc: suppressEquivalentJsonDiffs,
-				StateFunc: func(v interface{}) string {
-					json, _ := normalizeJsonString(v)
-					return json
-				},
+				StateFunc:        normalizeJsonStateFunc,
 			},
 			"arn": &schema.Schema{
 				Type:     schema.TypeString,
@@ -28,6 +28,11 @@ func resourceAwsSpotInstanceRequest() *schema.Resource {
 			// The Spot Instance Request Schema is based on the AWS Instance schema.
 			s := resourceAwsInstance().Schema
 
+			// The request itself defines how its instance is launched and
+			// replaced.
+			delete(s, "instance_market_options")
+			delete(s, "user_data_replace_on_change")
+
 			// Everything on a spot instance is ForceNew except tags
 			for k, v := range s {
 				if k == "tags" {
@@ -93,10 +93,7 @@ func resourceAwsSqsQueue() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				ValidateFunc: validateJsonString,
-				StateFunc: func(v interface{}) string {
-					json, _ := normalizeJsonString(v)
-					return json
-				},
+				StateFunc:    normalizeJsonStateFunc,
 			},
 			"arn": {
 				Type:     schema.TypeString,
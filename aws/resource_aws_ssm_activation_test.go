@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
@@ -23,6 +24,29 @@ func TestAccAWSSSMActivation_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAWSSSMActivationExists("aws_ssm_activation.foo"),
 					resource.TestCheckResourceAttrSet("aws_ssm_activation.foo", "activation_code"),
+					resource.TestCheckResourceAttrSet("aws_ssm_activation.foo", "expiration_date"),
+					resource.TestCheckResourceAttr("aws_ssm_activation.foo", "registration_limit", "5"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSSMActivation_expirationDate(t *testing.T) {
+	rName := acctest.RandString(10)
+	expirationTime := time.Now().Add(48 * time.Hour).UTC()
+	expirationDateS := expirationTime.Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSSMActivationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSSMActivationConfig_expirationDate(rName, expirationDateS),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSSMActivationExists("aws_ssm_activation.foo"),
+					resource.TestCheckResourceAttr("aws_ssm_activation.foo", "expiration_date", expirationDateS),
 				),
 			},
 		},
@@ -89,14 +113,37 @@ func testAccCheckAWSSSMActivationDestroy(s *terraform.State) error {
 		if len(out.ActivationList) > 0 {
 			return fmt.Errorf("Expected AWS SSM Activation to be gone, but was still found")
 		}
-
-		return nil
 	}
 
-	return fmt.Errorf("Default error in SSM Activation Test")
+	return nil
 }
 
 func testAccAWSSSMActivationBasicConfig(rName string) string {
+	return testAccAWSSSMActivationRoleConfig(rName) + fmt.Sprintf(`
+resource "aws_ssm_activation" "foo" {
+  name               = "test_ssm_activation-%s"
+  description        = "Test"
+  iam_role           = "${aws_iam_role.test_role.name}"
+  registration_limit = "5"
+  depends_on         = ["aws_iam_role_policy_attachment.test_attach"]
+}
+`, rName)
+}
+
+func testAccAWSSSMActivationConfig_expirationDate(rName, expirationDate string) string {
+	return testAccAWSSSMActivationRoleConfig(rName) + fmt.Sprintf(`
+resource "aws_ssm_activation" "foo" {
+  name               = "test_ssm_activation-%s"
+  description        = "Test"
+  expiration_date    = "%s"
+  iam_role           = "${aws_iam_role.test_role.name}"
+  registration_limit = "5"
+  depends_on         = ["aws_iam_role_policy_attachment.test_attach"]
+}
+`, rName, expirationDate)
+}
+
+func testAccAWSSSMActivationRoleConfig(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_iam_role" "test_role" {
   name = "test_role-%s"
@@ -120,13 +167,5 @@ resource "aws_iam_role_policy_attachment" "test_attach" {
   role = "${aws_iam_role.test_role.name}"
   policy_arn = "arn:aws:iam::aws:policy/service-role/AmazonEC2RoleforSSM"
 }
-
-resource "aws_ssm_activation" "foo" {
-  name               = "test_ssm_activation-%s",
-  description        = "Test"
-  iam_role           = "${aws_iam_role.test_role.name}"
-  registration_limit = "5"
-  depends_on         = ["aws_iam_role_policy_attachment.test_attach"]
-}
-`, rName, rName)
+`, rName)
 }
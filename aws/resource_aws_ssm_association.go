@@ -158,6 +158,11 @@ func resourceAwsSsmAssociationRead(d *schema.ResourceData, meta interface{}) err
 	resp, err := ssmconn.DescribeAssociation(params)
 
 	if err != nil {
+		if isAWSErr(err, ssm.ErrCodeAssociationDoesNotExist, "") {
+			log.Printf("[WARN] SSM Association (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
 		return errwrap.Wrapf("[ERROR] Error reading SSM association: {{err}}", err)
 	}
 	if resp.AssociationDescription == nil {
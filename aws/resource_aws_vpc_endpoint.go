@@ -9,6 +9,10 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/errwrap"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
 )
 
 func resourceAwsVpcEndpoint() *schema.Resource {
@@ -23,14 +27,11 @@ func resourceAwsVpcEndpoint() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"policy": &schema.Schema{
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateJsonString,
-				StateFunc: func(v interface{}) string {
-					json, _ := normalizeJsonString(v)
-					return json
-				},
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validateJsonString,
+				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
 			},
 			"vpc_id": &schema.Schema{
 				Type:     schema.TypeString,
@@ -42,6 +43,16 @@ func resourceAwsVpcEndpoint() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"vpc_endpoint_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  ec2.VpcEndpointTypeGateway,
+				ValidateFunc: validation.StringInSlice([]string{
+					ec2.VpcEndpointTypeGateway,
+					ec2.VpcEndpointTypeInterface,
+				}, false),
+			},
 			"route_table_ids": &schema.Schema{
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -49,6 +60,25 @@ func resourceAwsVpcEndpoint() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Set:      schema.HashString,
 			},
+			"subnet_ids": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"security_group_ids": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"private_dns_enabled": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"prefix_list_id": &schema.Schema{
 				Type:     schema.TypeString,
 				Computed: true,
@@ -58,6 +88,38 @@ func resourceAwsVpcEndpoint() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"network_interface_ids": &schema.Schema{
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"dns_entry": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dns_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"hosted_zone_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"state": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(waiter.VpcEndpointAvailableTimeout),
+			Update: schema.DefaultTimeout(waiter.VpcEndpointAvailableTimeout),
+			Delete: schema.DefaultTimeout(waiter.VpcEndpointDeletedTimeout),
 		},
 	}
 }
@@ -65,8 +127,9 @@ func resourceAwsVpcEndpoint() *schema.Resource {
 func resourceAwsVPCEndpointCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 	input := &ec2.CreateVpcEndpointInput{
-		VpcId:       aws.String(d.Get("vpc_id").(string)),
-		ServiceName: aws.String(d.Get("service_name").(string)),
+		VpcId:           aws.String(d.Get("vpc_id").(string)),
+		ServiceName:     aws.String(d.Get("service_name").(string)),
+		VpcEndpointType: aws.String(d.Get("vpc_endpoint_type").(string)),
 	}
 
 	if v, ok := d.GetOk("route_table_ids"); ok {
@@ -76,6 +139,25 @@ func resourceAwsVPCEndpointCreate(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
+	if v, ok := d.GetOk("subnet_ids"); ok {
+		list := v.(*schema.Set).List()
+		if len(list) > 0 {
+			input.SubnetIds = expandStringList(list)
+		}
+	}
+
+	if v, ok := d.GetOk("security_group_ids"); ok {
+		list := v.(*schema.Set).List()
+		if len(list) > 0 {
+			input.SecurityGroupIds = expandStringList(list)
+		}
+	}
+
+	// Private DNS can only be set for Interface endpoints.
+	if d.Get("vpc_endpoint_type").(string) == ec2.VpcEndpointTypeInterface {
+		input.PrivateDnsEnabled = aws.Bool(d.Get("private_dns_enabled").(bool))
+	}
+
 	if v, ok := d.GetOk("policy"); ok {
 		policy, err := normalizeJsonString(v)
 		if err != nil {
@@ -93,43 +175,72 @@ func resourceAwsVPCEndpointCreate(d *schema.ResourceData, meta interface{}) erro
 
 	d.SetId(*output.VpcEndpoint.VpcEndpointId)
 
+	if _, err := waiter.VpcEndpointAvailable(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("Error waiting for VPC Endpoint (%s) to become available: %s", d.Id(), err)
+	}
+
 	return resourceAwsVPCEndpointRead(d, meta)
 }
 
 func resourceAwsVPCEndpointRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
-	input := &ec2.DescribeVpcEndpointsInput{
-		VpcEndpointIds: []*string{aws.String(d.Id())},
-	}
 
 	log.Printf("[DEBUG] Reading VPC Endpoint: %q", d.Id())
-	output, err := conn.DescribeVpcEndpoints(input)
+	vpce, err := finder.VpcEndpointByID(conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		log.Printf("[WARN] VPC Endpoint (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
 
 	if err != nil {
-		ec2err, ok := err.(awserr.Error)
-		if !ok {
-			return fmt.Errorf("Error reading VPC Endpoint: %s", err.Error())
-		}
+		return fmt.Errorf("Error reading VPC Endpoint: %s", err.Error())
+	}
 
-		if ec2err.Code() == "InvalidVpcEndpointId.NotFound" {
-			log.Printf("[WARN] VPC Endpoint (%s) not found, removing from state", d.Id())
-			d.SetId("")
-			return nil
-		}
+	vpceType := aws.StringValue(vpce.VpcEndpointType)
+	if vpceType == "" {
+		// Endpoints created before Interface endpoints were introduced
+		// don't report a type.
+		vpceType = ec2.VpcEndpointTypeGateway
+	}
 
-		return fmt.Errorf("Error reading VPC Endpoint: %s", err.Error())
+	policy, err := normalizeJsonString(aws.StringValue(vpce.PolicyDocument))
+	if err != nil {
+		return errwrap.Wrapf("policy contains an invalid JSON: {{err}}", err)
 	}
 
-	if len(output.VpcEndpoints) != 1 {
-		return fmt.Errorf("There's no unique VPC Endpoint, but %d endpoints: %#v",
-			len(output.VpcEndpoints), output.VpcEndpoints)
+	d.Set("vpc_id", vpce.VpcId)
+	d.Set("policy", policy)
+	d.Set("service_name", vpce.ServiceName)
+	d.Set("vpc_endpoint_type", vpceType)
+	d.Set("private_dns_enabled", vpce.PrivateDnsEnabled)
+	d.Set("state", vpce.State)
+	if err := d.Set("route_table_ids", aws.StringValueSlice(vpce.RouteTableIds)); err != nil {
+		return err
+	}
+	if err := d.Set("subnet_ids", aws.StringValueSlice(vpce.SubnetIds)); err != nil {
+		return err
+	}
+	if err := d.Set("network_interface_ids", aws.StringValueSlice(vpce.NetworkInterfaceIds)); err != nil {
+		return err
+	}
+	if err := d.Set("security_group_ids", flattenVpcEndpointSecurityGroupIds(vpce.Groups)); err != nil {
+		return err
+	}
+	if err := d.Set("dns_entry", flattenVpcEndpointDnsEntries(vpce.DnsEntries)); err != nil {
+		return err
 	}
 
-	vpce := output.VpcEndpoints[0]
+	if vpceType != ec2.VpcEndpointTypeGateway {
+		d.Set("prefix_list_id", "")
+		d.Set("cidr_blocks", []string{})
+		return nil
+	}
 
-	// A VPC Endpoint is associated with exactly one prefix list name (also called Service Name).
+	// A Gateway VPC Endpoint is associated with exactly one prefix list name (also called Service Name).
 	// The prefix list ID can be used in security groups, so retrieve it to support that capability.
-	prefixListServiceName := *vpce.ServiceName
+	prefixListServiceName := aws.StringValue(vpce.ServiceName)
 	prefixListInput := &ec2.DescribePrefixListsInput{
 		Filters: []*ec2.Filter{
 			{Name: aws.String("prefix-list-name"), Values: []*string{aws.String(prefixListServiceName)}},
@@ -140,27 +251,13 @@ func resourceAwsVPCEndpointRead(d *schema.ResourceData, meta interface{}) error
 	prefixListsOutput, err := conn.DescribePrefixLists(prefixListInput)
 
 	if err != nil {
-		_, ok := err.(awserr.Error)
-		if !ok {
-			return fmt.Errorf("Error reading VPC Endpoint prefix list: %s", err.Error())
-		}
+		return fmt.Errorf("Error reading VPC Endpoint prefix list: %s", err.Error())
 	}
 
 	if len(prefixListsOutput.PrefixLists) != 1 {
 		return fmt.Errorf("There are multiple prefix lists associated with the service name '%s'. Unexpected", prefixListServiceName)
 	}
 
-	policy, err := normalizeJsonString(*vpce.PolicyDocument)
-	if err != nil {
-		return errwrap.Wrapf("policy contains an invalid JSON: {{err}}", err)
-	}
-
-	d.Set("vpc_id", vpce.VpcId)
-	d.Set("policy", policy)
-	d.Set("service_name", vpce.ServiceName)
-	if err := d.Set("route_table_ids", aws.StringValueSlice(vpce.RouteTableIds)); err != nil {
-		return err
-	}
 	pl := prefixListsOutput.PrefixLists[0]
 	d.Set("prefix_list_id", pl.PrefixListId)
 	d.Set("cidr_blocks", aws.StringValueSlice(pl.Cidrs))
@@ -175,19 +272,19 @@ func resourceAwsVPCEndpointUpdate(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	if d.HasChange("route_table_ids") {
-		o, n := d.GetChange("route_table_ids")
-		os := o.(*schema.Set)
-		ns := n.(*schema.Set)
+		input.AddRouteTableIds, input.RemoveRouteTableIds = expandVpcEndpointSetChange(d, "route_table_ids")
+	}
 
-		add := expandStringList(ns.Difference(os).List())
-		if len(add) > 0 {
-			input.AddRouteTableIds = add
-		}
+	if d.HasChange("subnet_ids") {
+		input.AddSubnetIds, input.RemoveSubnetIds = expandVpcEndpointSetChange(d, "subnet_ids")
+	}
 
-		remove := expandStringList(os.Difference(ns).List())
-		if len(remove) > 0 {
-			input.RemoveRouteTableIds = remove
-		}
+	if d.HasChange("security_group_ids") {
+		input.AddSecurityGroupIds, input.RemoveSecurityGroupIds = expandVpcEndpointSetChange(d, "security_group_ids")
+	}
+
+	if d.HasChange("private_dns_enabled") && d.Get("vpc_endpoint_type").(string) == ec2.VpcEndpointTypeInterface {
+		input.PrivateDnsEnabled = aws.Bool(d.Get("private_dns_enabled").(bool))
 	}
 
 	if d.HasChange("policy") {
@@ -203,7 +300,11 @@ func resourceAwsVPCEndpointUpdate(d *schema.ResourceData, meta interface{}) erro
 	if err != nil {
 		return fmt.Errorf("Error updating VPC Endpoint: %s", err)
 	}
-	log.Printf("[DEBUG] VPC Endpoint %q updated", input.VpcEndpointId)
+	log.Printf("[DEBUG] VPC Endpoint %q updated", d.Id())
+
+	if _, err := waiter.VpcEndpointAvailable(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return fmt.Errorf("Error waiting for VPC Endpoint (%s) to become available: %s", d.Id(), err)
+	}
 
 	return resourceAwsVPCEndpointRead(d, meta)
 }
@@ -225,13 +326,54 @@ func resourceAwsVPCEndpointDelete(d *schema.ResourceData, meta interface{}) erro
 
 		if ec2err.Code() == "InvalidVpcEndpointId.NotFound" {
 			log.Printf("[DEBUG] VPC Endpoint %q is already gone", d.Id())
-		} else {
-			return fmt.Errorf("Error deleting VPC Endpoint: %s", err.Error())
+			return nil
 		}
+
+		return fmt.Errorf("Error deleting VPC Endpoint: %s", err.Error())
+	}
+
+	if _, err := waiter.VpcEndpointDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("Error waiting for VPC Endpoint (%s) to be deleted: %s", d.Id(), err)
 	}
 
 	log.Printf("[DEBUG] VPC Endpoint %q deleted", d.Id())
-	d.SetId("")
 
 	return nil
 }
+
+// expandVpcEndpointSetChange returns the IDs added to and removed from the
+// given set attribute, or nil for either when there are none.
+func expandVpcEndpointSetChange(d *schema.ResourceData, key string) ([]*string, []*string) {
+	o, n := d.GetChange(key)
+	os := o.(*schema.Set)
+	ns := n.(*schema.Set)
+
+	var add, remove []*string
+	if v := ns.Difference(os).List(); len(v) > 0 {
+		add = expandStringList(v)
+	}
+	if v := os.Difference(ns).List(); len(v) > 0 {
+		remove = expandStringList(v)
+	}
+
+	return add, remove
+}
+
+func flattenVpcEndpointSecurityGroupIds(groups []*ec2.SecurityGroupIdentifier) []string {
+	ids := make([]string, 0, len(groups))
+	for _, group := range groups {
+		ids = append(ids, aws.StringValue(group.GroupId))
+	}
+	return ids
+}
+
+func flattenVpcEndpointDnsEntries(entries []*ec2.DnsEntry) []map[string]interface{} {
+	dnsEntries := make([]map[string]interface{}, 0, len(entries))
+	for _, entry := range entries {
+		dnsEntries = append(dnsEntries, map[string]interface{}{
+			"dns_name":       aws.StringValue(entry.DnsName),
+			"hosted_zone_id": aws.StringValue(entry.HostedZoneId),
+		})
+	}
+	return dnsEntries
+}
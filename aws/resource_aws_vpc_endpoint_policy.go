@@ -0,0 +1,131 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsVpcEndpointPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsVpcEndpointPolicyPut,
+		Read:   resourceAwsVpcEndpointPolicyRead,
+		Update: resourceAwsVpcEndpointPolicyPut,
+		Delete: resourceAwsVpcEndpointPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"vpc_endpoint_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"policy": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validateJsonString,
+				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(waiter.VpcEndpointAvailableTimeout),
+			Update: schema.DefaultTimeout(waiter.VpcEndpointAvailableTimeout),
+			Delete: schema.DefaultTimeout(waiter.VpcEndpointAvailableTimeout),
+		},
+	}
+}
+
+func resourceAwsVpcEndpointPolicyPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+	endpointId := d.Get("vpc_endpoint_id").(string)
+
+	policy, err := normalizeJsonString(d.Get("policy"))
+	if err != nil {
+		return errwrap.Wrapf("policy contains an invalid JSON: {{err}}", err)
+	}
+
+	input := &ec2.ModifyVpcEndpointInput{
+		VpcEndpointId:  aws.String(endpointId),
+		PolicyDocument: aws.String(policy),
+	}
+
+	log.Printf("[DEBUG] Putting VPC Endpoint Policy: %s", input)
+	if _, err := conn.ModifyVpcEndpoint(input); err != nil {
+		return fmt.Errorf("Error putting VPC Endpoint (%s) policy: %s", endpointId, err)
+	}
+
+	d.SetId(endpointId)
+
+	timeout := d.Timeout(schema.TimeoutCreate)
+	if !d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutUpdate)
+	}
+	if _, err := waiter.VpcEndpointAvailable(conn, d.Id(), timeout); err != nil {
+		return fmt.Errorf("Error waiting for VPC Endpoint (%s) to become available: %s", d.Id(), err)
+	}
+
+	return resourceAwsVpcEndpointPolicyRead(d, meta)
+}
+
+func resourceAwsVpcEndpointPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	vpce, err := finder.VpcEndpointByID(conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		log.Printf("[WARN] VPC Endpoint (%s) not found, removing policy from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("Error reading VPC Endpoint (%s): %s", d.Id(), err)
+	}
+
+	policy, err := normalizeJsonString(aws.StringValue(vpce.PolicyDocument))
+	if err != nil {
+		return errwrap.Wrapf("policy contains an invalid JSON: {{err}}", err)
+	}
+
+	d.Set("vpc_endpoint_id", d.Id())
+	d.Set("policy", policy)
+
+	return nil
+}
+
+func resourceAwsVpcEndpointPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	// Removing the policy restores the default policy, which allows full access.
+	input := &ec2.ModifyVpcEndpointInput{
+		VpcEndpointId: aws.String(d.Id()),
+		ResetPolicy:   aws.Bool(true),
+	}
+
+	log.Printf("[DEBUG] Resetting VPC Endpoint Policy: %s", input)
+	_, err := conn.ModifyVpcEndpoint(input)
+
+	if isAWSErr(err, "InvalidVpcEndpointId.NotFound", "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("Error resetting VPC Endpoint (%s) policy: %s", d.Id(), err)
+	}
+
+	if _, err := waiter.VpcEndpointAvailable(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("Error waiting for VPC Endpoint (%s) to become available: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,134 @@
+package aws
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/finder"
+)
+
+func TestAccAWSVpcEndpointPolicy_basic(t *testing.T) {
+	var endpoint ec2.VpcEndpoint
+	resourceName := "aws_vpc_endpoint_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVpcEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpcEndpointPolicyConfig("s3:GetObject"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcEndpointExists("aws_vpc_endpoint.test", &endpoint),
+					testAccCheckVpcEndpointPolicyContains(&endpoint, "s3:GetObject"),
+				),
+			},
+			{
+				Config: testAccVpcEndpointPolicyConfig("s3:PutObject"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcEndpointExists("aws_vpc_endpoint.test", &endpoint),
+					testAccCheckVpcEndpointPolicyContains(&endpoint, "s3:PutObject"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSVpcEndpointPolicy_disappears(t *testing.T) {
+	var endpoint ec2.VpcEndpoint
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVpcEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpcEndpointPolicyConfig("s3:GetObject"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcEndpointExists("aws_vpc_endpoint.test", &endpoint),
+					testAccCheckVpcEndpointPolicyReset(&endpoint),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckVpcEndpointPolicyContains(endpoint *ec2.VpcEndpoint, action string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		policy, err := normalizeJsonString(aws.StringValue(endpoint.PolicyDocument))
+		if err != nil {
+			return err
+		}
+
+		if strings.Contains(policy, fmt.Sprintf("%q", action)) {
+			return nil
+		}
+
+		return fmt.Errorf("VPC Endpoint policy does not contain %s: %s", action, policy)
+	}
+}
+
+// testAccCheckVpcEndpointPolicyReset restores the default policy outside of Terraform.
+func testAccCheckVpcEndpointPolicyReset(endpoint *ec2.VpcEndpoint) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		_, err := conn.ModifyVpcEndpoint(&ec2.ModifyVpcEndpointInput{
+			VpcEndpointId: endpoint.VpcEndpointId,
+			ResetPolicy:   aws.Bool(true),
+		})
+		if err != nil {
+			return err
+		}
+
+		_, err = finder.VpcEndpointByID(conn, aws.StringValue(endpoint.VpcEndpointId))
+		return err
+	}
+}
+
+func testAccVpcEndpointPolicyConfig(action string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags {
+    Name = "terraform-testacc-vpc-endpoint-policy"
+  }
+}
+
+resource "aws_vpc_endpoint" "test" {
+  vpc_id       = "${aws_vpc.test.id}"
+  service_name = "com.amazonaws.us-west-2.s3"
+}
+
+resource "aws_vpc_endpoint_policy" "test" {
+  vpc_endpoint_id = "${aws_vpc_endpoint.test.id}"
+
+  policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "AllowAction",
+      "Effect": "Allow",
+      "Principal": "*",
+      "Action": "%s",
+      "Resource": "*"
+    }
+  ]
+}
+POLICY
+}
+`, action)
+}
@@ -7,11 +7,13 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ec2"
 
+	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
 )
 
 func TestAccAWSVpcEndpoint_basic(t *testing.T) {
@@ -82,6 +84,45 @@ func TestAccAWSVpcEndpoint_WithoutRouteTableOrPolicyConfig(t *testing.T) {
 	})
 }
 
+func TestAccAWSVpcEndpoint_interfaceType(t *testing.T) {
+	var endpoint ec2.VpcEndpoint
+	resourceName := "aws_vpc_endpoint.ec2"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVpcEndpointDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccVpcEndpointConfigInterfaceType(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcEndpointExists(resourceName, &endpoint),
+					resource.TestCheckResourceAttr(resourceName, "vpc_endpoint_type", "Interface"),
+					resource.TestCheckResourceAttr(resourceName, "private_dns_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "subnet_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "security_group_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "network_interface_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "prefix_list_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "state", "available"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccVpcEndpointConfigInterfaceType(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcEndpointExists(resourceName, &endpoint),
+					resource.TestCheckResourceAttr(resourceName, "private_dns_enabled", "true"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccAWSVpcEndpoint_removed(t *testing.T) {
 	var endpoint ec2.VpcEndpoint
 
@@ -124,23 +165,15 @@ func testAccCheckVpcEndpointDestroy(s *terraform.State) error {
 			continue
 		}
 
-		// Try to find the VPC
-		input := &ec2.DescribeVpcEndpointsInput{
-			VpcEndpointIds: []*string{aws.String(rs.Primary.ID)},
+		_, err := finder.VpcEndpointByID(conn, rs.Primary.ID)
+		if tfresource.NotFound(err) {
+			continue
 		}
-		resp, err := conn.DescribeVpcEndpoints(input)
 		if err != nil {
-			// Verify the error is what we want
-			if ae, ok := err.(awserr.Error); ok && ae.Code() == "InvalidVpcEndpointId.NotFound" {
-				continue
-			}
 			return err
 		}
-		if len(resp.VpcEndpoints) > 0 {
-			return fmt.Errorf("VPC Endpoints still exist.")
-		}
 
-		return err
+		return fmt.Errorf("VPC Endpoint %s still exists", rs.Primary.ID)
 	}
 
 	return nil
@@ -305,3 +338,44 @@ resource "aws_vpc_endpoint" "second-private-s3" {
     service_name = "com.amazonaws.us-west-2.s3"
 }
 `
+
+func testAccVpcEndpointConfigInterfaceType(rName string, privateDnsEnabled bool) string {
+	return fmt.Sprintf(`
+data "aws_availability_zones" "available" {}
+
+resource "aws_vpc" "foo" {
+  cidr_block           = "10.0.0.0/16"
+  enable_dns_support   = true
+  enable_dns_hostnames = true
+
+  tags {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "foo" {
+  vpc_id            = "${aws_vpc.foo.id}"
+  cidr_block        = "10.0.1.0/24"
+  availability_zone = "${data.aws_availability_zones.available.names[0]}"
+
+  tags {
+    Name = %[1]q
+  }
+}
+
+resource "aws_security_group" "foo" {
+  vpc_id = "${aws_vpc.foo.id}"
+  name   = %[1]q
+}
+
+resource "aws_vpc_endpoint" "ec2" {
+  vpc_id            = "${aws_vpc.foo.id}"
+  service_name      = "com.amazonaws.us-west-2.ec2"
+  vpc_endpoint_type = "Interface"
+
+  subnet_ids          = ["${aws_subnet.foo.id}"]
+  security_group_ids  = ["${aws_security_group.foo.id}"]
+  private_dns_enabled = %[2]t
+}
+`, rName, privateDnsEnabled)
+}
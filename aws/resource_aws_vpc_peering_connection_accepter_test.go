@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 )
 
@@ -22,6 +23,30 @@ func TestAccAwsVPCPeeringConnectionAccepter_sameAccount(t *testing.T) {
 	})
 }
 
+func TestAccAwsVPCPeeringConnectionAccepter_alternateAccount(t *testing.T) {
+	var providers []*schema.Provider
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccAlternateAccountPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactories(&providers),
+		CheckDestroy:      testAccAwsVPCPeeringConnectionAccepterDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAwsVPCPeeringConnectionAccepterAlternateAccountConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_vpc_peering_connection_accepter.peer", "accept_status", "active"),
+					// The accepter sees the connection from the other side.
+					resource.TestCheckResourceAttrPair("aws_vpc_peering_connection_accepter.peer", "vpc_id", "aws_vpc.peer", "id"),
+					resource.TestCheckResourceAttrPair("aws_vpc_peering_connection_accepter.peer", "peer_vpc_id", "aws_vpc.main", "id"),
+				),
+			},
+		},
+	})
+}
+
 func testAccAwsVPCPeeringConnectionAccepterDestroy(s *terraform.State) error {
 	// We don't destroy the underlying VPC Peering Connection.
 	return nil
@@ -75,3 +100,51 @@ resource "aws_vpc_peering_connection_accepter" "peer" {
     }
 }
 `
+
+func testAccAwsVPCPeeringConnectionAccepterAlternateAccountConfig() string {
+	return testAccAlternateAccountProviderConfig() + `
+resource "aws_vpc" "main" {
+    cidr_block = "10.0.0.0/16"
+
+    tags {
+      Name = "terraform-testacc-vpc-peering-connection-accepter-requester"
+    }
+}
+
+resource "aws_vpc" "peer" {
+    provider = "aws.alternate"
+    cidr_block = "10.1.0.0/16"
+
+    tags {
+      Name = "terraform-testacc-vpc-peering-connection-accepter-accepter"
+    }
+}
+
+data "aws_caller_identity" "peer" {
+    provider = "aws.alternate"
+}
+
+// Requester's side of the connection.
+resource "aws_vpc_peering_connection" "peer" {
+    vpc_id = "${aws_vpc.main.id}"
+    peer_vpc_id = "${aws_vpc.peer.id}"
+    peer_owner_id = "${data.aws_caller_identity.peer.account_id}"
+    auto_accept = false
+
+    tags {
+      Side = "Requester"
+    }
+}
+
+// Accepter's side of the connection.
+resource "aws_vpc_peering_connection_accepter" "peer" {
+    provider = "aws.alternate"
+    vpc_peering_connection_id = "${aws_vpc_peering_connection.peer.id}"
+    auto_accept = true
+
+    tags {
+       Side = "Accepter"
+    }
+}
+`
+}
@@ -0,0 +1,137 @@
+package aws
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// Read functions which are allowed to skip removing the resource from state
+// when it no longer exists, e.g. because the resource is an account-level
+// singleton that can never be deleted out-of-band.
+var resourceReadNotFoundExemptions = map[string]string{
+	"resourceAwsApiGatewayAccountRead": "account-level singleton",
+	"resourceAwsSnsSmsPreferencesGet":  "account-level singleton",
+}
+
+// TestResourceReadsRemoveMissingResources verifies that every resource either
+// implements Exists or has a Read function that (directly or through a helper
+// in this package) calls d.SetId("") so resources deleted out-of-band are
+// removed from state instead of failing the refresh.
+func TestResourceReadsRemoveMissingResources(t *testing.T) {
+	files, err := filepath.Glob("resource_aws_*.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fset := token.NewFileSet()
+	funcs := make(map[string]*ast.FuncDecl)
+	reads := make(map[string]string)
+	exists := make(map[string]bool)
+
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+
+		f, err := parser.ParseFile(fset, file, nil, 0)
+		if err != nil {
+			t.Fatalf("error parsing %s: %s", file, err)
+		}
+
+		for _, decl := range f.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok {
+				continue
+			}
+			funcs[fn.Name.Name] = fn
+
+			ast.Inspect(fn, func(n ast.Node) bool {
+				kv, ok := n.(*ast.KeyValueExpr)
+				if !ok {
+					return true
+				}
+				key, ok := kv.Key.(*ast.Ident)
+				if !ok {
+					return true
+				}
+				value, ok := kv.Value.(*ast.Ident)
+				if !ok {
+					return true
+				}
+
+				switch key.Name {
+				case "Read":
+					reads[value.Name] = file
+				case "Exists":
+					exists[file] = true
+				}
+				return true
+			})
+		}
+	}
+
+	var failures []string
+	for name, file := range reads {
+		if _, ok := resourceReadNotFoundExemptions[name]; ok {
+			continue
+		}
+		if exists[file] {
+			continue
+		}
+
+		fn, ok := funcs[name]
+		if !ok {
+			// Read functions shared from other files (e.g. data sources)
+			continue
+		}
+
+		if !callsSetIdEmpty(fn, funcs, make(map[string]bool)) {
+			failures = append(failures, name+" ("+file+")")
+		}
+	}
+
+	sort.Strings(failures)
+	for _, failure := range failures {
+		t.Errorf("%s does not remove the resource from state when it is not found", failure)
+	}
+}
+
+func callsSetIdEmpty(fn *ast.FuncDecl, funcs map[string]*ast.FuncDecl, seen map[string]bool) bool {
+	if seen[fn.Name.Name] {
+		return false
+	}
+	seen[fn.Name.Name] = true
+
+	found := false
+	ast.Inspect(fn, func(n ast.Node) bool {
+		if found {
+			return false
+		}
+
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+
+		switch f := call.Fun.(type) {
+		case *ast.SelectorExpr:
+			if f.Sel.Name == "SetId" && len(call.Args) == 1 {
+				if lit, ok := call.Args[0].(*ast.BasicLit); ok && lit.Value == `""` {
+					found = true
+				}
+			}
+		case *ast.Ident:
+			if helper, ok := funcs[f.Name]; ok && callsSetIdEmpty(helper, funcs, seen) {
+				found = true
+			}
+		}
+		return true
+	})
+
+	return found
+}
@@ -0,0 +1,179 @@
+package aws
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sort"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/mitchellh/go-homedir"
+)
+
+const (
+	// s3MinUploadPartSize is the minimum size of all but the last part of a
+	// multipart upload, and the default part size used by this provider.
+	s3MinUploadPartSize int64 = 1024 * 1024 * 5
+
+	// s3MaxUploadParts is the maximum number of parts in a multipart upload.
+	s3MaxUploadParts int64 = 10000
+
+	// s3DefaultUploadConcurrency is the default number of parts uploaded in parallel.
+	s3DefaultUploadConcurrency = 5
+)
+
+// s3UploadPartSize returns the part size to use when uploading an object of
+// the given size, growing the requested part size if needed so that the
+// upload fits within the maximum number of parts.
+func s3UploadPartSize(size, partSize int64) int64 {
+	if partSize < s3MinUploadPartSize {
+		partSize = s3MinUploadPartSize
+	}
+	if size/partSize >= s3MaxUploadParts {
+		partSize = size/s3MaxUploadParts + 1
+	}
+	return partSize
+}
+
+// s3ObjectSourceHash returns the hex encoded MD5 sum of the file at path,
+// reading it in chunks rather than loading it into memory.
+func s3ObjectSourceHash(path string) (string, error) {
+	path, err := homedir.Expand(path)
+	if err != nil {
+		return "", err
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	return md5Reader(f)
+}
+
+func md5Reader(r io.Reader) (string, error) {
+	h := md5.New()
+	if _, err := io.Copy(h, r); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+type s3UploadPart struct {
+	number int64
+	offset int64
+	size   int64
+}
+
+// s3MultipartUpload uploads size bytes of body as a multipart upload using up
+// to concurrency parallel UploadPart requests. Each part is read directly from
+// body so the object is never held in memory. The upload is aborted if any
+// part fails.
+func s3MultipartUpload(conn *s3.S3, input *s3.CreateMultipartUploadInput, body io.ReaderAt, size, partSize int64, concurrency int) (*s3.CompleteMultipartUploadOutput, error) {
+	if concurrency < 1 {
+		concurrency = s3DefaultUploadConcurrency
+	}
+	partSize = s3UploadPartSize(size, partSize)
+
+	log.Printf("[DEBUG] Creating S3 multipart upload: %s", input)
+	createResp, err := conn.CreateMultipartUpload(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error creating multipart upload: %s", err)
+	}
+	uploadId := createResp.UploadId
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var uploadErr error
+	completed := make([]*s3.CompletedPart, 0, size/partSize+1)
+	parts := make(chan s3UploadPart)
+
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for part := range parts {
+				mu.Lock()
+				failed := uploadErr != nil
+				mu.Unlock()
+				if failed {
+					continue
+				}
+
+				log.Printf("[DEBUG] Uploading S3 multipart upload (%s) part %d (%d bytes)", aws.StringValue(uploadId), part.number, part.size)
+				resp, err := conn.UploadPart(&s3.UploadPartInput{
+					Bucket:        input.Bucket,
+					Key:           input.Key,
+					UploadId:      uploadId,
+					PartNumber:    aws.Int64(part.number),
+					ContentLength: aws.Int64(part.size),
+					Body:          io.NewSectionReader(body, part.offset, part.size),
+				})
+
+				mu.Lock()
+				if err != nil {
+					if uploadErr == nil {
+						uploadErr = fmt.Errorf("Error uploading part %d: %s", part.number, err)
+					}
+				} else {
+					completed = append(completed, &s3.CompletedPart{
+						ETag:       resp.ETag,
+						PartNumber: aws.Int64(part.number),
+					})
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	for number, offset := int64(1), int64(0); offset < size; number, offset = number+1, offset+partSize {
+		n := partSize
+		if offset+n > size {
+			n = size - offset
+		}
+		parts <- s3UploadPart{number: number, offset: offset, size: n}
+	}
+	close(parts)
+	wg.Wait()
+
+	if uploadErr != nil {
+		abortS3MultipartUpload(conn, input.Bucket, input.Key, uploadId)
+		return nil, uploadErr
+	}
+
+	sort.Slice(completed, func(i, j int) bool {
+		return aws.Int64Value(completed[i].PartNumber) < aws.Int64Value(completed[j].PartNumber)
+	})
+
+	resp, err := conn.CompleteMultipartUpload(&s3.CompleteMultipartUploadInput{
+		Bucket:   input.Bucket,
+		Key:      input.Key,
+		UploadId: uploadId,
+		MultipartUpload: &s3.CompletedMultipartUpload{
+			Parts: completed,
+		},
+	})
+	if err != nil {
+		abortS3MultipartUpload(conn, input.Bucket, input.Key, uploadId)
+		return nil, fmt.Errorf("Error completing multipart upload: %s", err)
+	}
+
+	return resp, nil
+}
+
+func abortS3MultipartUpload(conn *s3.S3, bucket, key, uploadId *string) {
+	_, err := conn.AbortMultipartUpload(&s3.AbortMultipartUploadInput{
+		Bucket:   bucket,
+		Key:      key,
+		UploadId: uploadId,
+	})
+	if err != nil {
+		log.Printf("[WARN] Error aborting S3 multipart upload (%s): %s", aws.StringValue(uploadId), err)
+	}
+}
@@ -0,0 +1,58 @@
+package aws
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestS3UploadPartSize(t *testing.T) {
+	cases := []struct {
+		Size     int64
+		PartSize int64
+		Expected int64
+	}{
+		{
+			Size:     1024,
+			PartSize: 0,
+			Expected: s3MinUploadPartSize,
+		},
+		{
+			Size:     1024,
+			PartSize: 1024,
+			Expected: s3MinUploadPartSize,
+		},
+		{
+			Size:     1024 * 1024 * 100,
+			PartSize: 1024 * 1024 * 10,
+			Expected: 1024 * 1024 * 10,
+		},
+		{
+			// 100 GiB does not fit in 10,000 parts of 5 MiB
+			Size:     1024 * 1024 * 1024 * 100,
+			PartSize: s3MinUploadPartSize,
+			Expected: 1024*1024*1024*100/s3MaxUploadParts + 1,
+		},
+	}
+
+	for _, tc := range cases {
+		actual := s3UploadPartSize(tc.Size, tc.PartSize)
+		if actual != tc.Expected {
+			t.Fatalf("size %d, part size %d: expected %d, got %d", tc.Size, tc.PartSize, tc.Expected, actual)
+		}
+		if parts := (tc.Size + actual - 1) / actual; parts > s3MaxUploadParts {
+			t.Fatalf("size %d, part size %d: %d parts exceeds maximum", tc.Size, tc.PartSize, parts)
+		}
+	}
+}
+
+func TestMd5Reader(t *testing.T) {
+	hash, err := md5Reader(strings.NewReader("{anything will do }"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "7b006ff4d70f68cc65061acf2f802e6f"
+	if hash != expected {
+		t.Fatalf("expected %s, got %s", expected, hash)
+	}
+}
@@ -1988,6 +1988,13 @@ func normalizeJsonString(jsonString interface{}) (string, error) {
 	return string(bytes[:]), nil
 }
 
+// normalizeJsonStateFunc is a StateFunc for JSON document attributes. It saves
+// the normalized document, or the original value if it is not valid JSON.
+func normalizeJsonStateFunc(v interface{}) string {
+	json, _ := normalizeJsonString(v)
+	return json
+}
+
 // Takes a value containing YAML string and passes it through
 // the YAML parser. Returns either a parsing
 // error or original YAML string.
@@ -2016,6 +2023,13 @@ func normalizeCloudFormationTemplate(templateString interface{}) (string, error)
 	}
 }
 
+// normalizeCloudFormationTemplateStateFunc is a StateFunc for JSON or YAML
+// template attributes.
+func normalizeCloudFormationTemplateStateFunc(v interface{}) string {
+	template, _ := normalizeCloudFormationTemplate(v)
+	return template
+}
+
 func flattenInspectorTags(cfTags []*cloudformation.Tag) map[string]string {
 	tags := make(map[string]string, len(cfTags))
 	for _, t := range cfTags {
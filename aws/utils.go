@@ -3,8 +3,11 @@ package aws
 import (
 	"encoding/base64"
 	"encoding/json"
+	"fmt"
 	"reflect"
 	"regexp"
+
+	"gopkg.in/yaml.v2"
 )
 
 // Base64Encode encodes data if the input isn't already encoded using base64.StdEncoding.EncodeToString.
@@ -40,3 +43,74 @@ func jsonBytesEqual(b1, b2 []byte) bool {
 
 	return reflect.DeepEqual(o1, o2)
 }
+
+// yamlTagRegexp matches explicit YAML tags such as the CloudFormation short
+// form intrinsic functions (!Ref, !GetAtt), which are lost when decoding.
+var yamlTagRegexp = regexp.MustCompile(`(^|[\s\[{,:-])![A-Za-z]`)
+
+// jsonOrYamlStringsEqual reports whether two JSON or YAML documents decode to
+// the same value, regardless of formatting, key order or which of the two
+// formats each document is written in.
+func jsonOrYamlStringsEqual(s1, s2 string) bool {
+	o1, err := decodeJsonOrYamlString(s1)
+	if err != nil {
+		return false
+	}
+
+	o2, err := decodeJsonOrYamlString(s2)
+	if err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(o1, o2)
+}
+
+// decodeJsonOrYamlString decodes a JSON or YAML document into the values
+// encoding/json would produce for the equivalent JSON document.
+func decodeJsonOrYamlString(s string) (interface{}, error) {
+	var o interface{}
+
+	if looksLikeJsonString(s) {
+		err := json.Unmarshal([]byte(s), &o)
+		return o, err
+	}
+
+	if yamlTagRegexp.MatchString(s) {
+		return nil, fmt.Errorf("YAML documents with explicit tags cannot be compared")
+	}
+
+	if err := yaml.Unmarshal([]byte(s), &o); err != nil {
+		return nil, err
+	}
+
+	// Round trip through JSON so that maps and numbers have the same types
+	// as when decoding a JSON document.
+	b, err := json.Marshal(yamlToJsonCompatible(o))
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(b, &o)
+	return o, err
+}
+
+// yamlToJsonCompatible converts the map[interface{}]interface{} values
+// produced by the YAML decoder into map[string]interface{}.
+func yamlToJsonCompatible(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, e := range v {
+			m[fmt.Sprintf("%v", k)] = yamlToJsonCompatible(e)
+		}
+		return m
+	case []interface{}:
+		l := make([]interface{}, len(v))
+		for i, e := range v {
+			l[i] = yamlToJsonCompatible(e)
+		}
+		return l
+	default:
+		return v
+	}
+}
@@ -70,3 +70,47 @@ func TestJsonBytesEqualWhitespaceAndNoWhitespace(t *testing.T) {
 		t.Errorf("Expected jsonBytesEqual to return false for %s == %s", noWhitespaceDiff, whitespaceDiff)
 	}
 }
+
+func TestJsonOrYamlStringsEqual(t *testing.T) {
+	cases := []struct {
+		s1, s2 string
+		equal  bool
+	}{
+		{
+			s1:    `{"Resources":{"Vpc":{"Type":"AWS::EC2::VPC","Properties":{"CidrBlock":"10.0.0.0/16"}}}}`,
+			s2:    "Resources:\n  Vpc:\n    Type: AWS::EC2::VPC\n    Properties:\n      CidrBlock: 10.0.0.0/16\n",
+			equal: true,
+		},
+		{
+			s1:    "# comment\nResources:\n  Vpc:\n    Type: AWS::EC2::VPC\n",
+			s2:    "Resources:\n    Vpc: {Type: 'AWS::EC2::VPC'}\n",
+			equal: true,
+		},
+		{
+			s1:    "Parameters:\n  Count:\n    Default: 1\n",
+			s2:    `{"Parameters":{"Count":{"Default":1}}}`,
+			equal: true,
+		},
+		{
+			s1:    "Parameters:\n  Count:\n    Default: 1\n",
+			s2:    "Parameters:\n  Count:\n    Default: 2\n",
+			equal: false,
+		},
+		{
+			s1:    "Outputs:\n  Id:\n    Value: !Ref Vpc\n",
+			s2:    "Outputs:\n  Id:\n    Value: !Sub Vpc\n",
+			equal: false,
+		},
+		{
+			s1:    `{"test":"test"}`,
+			s2:    `{"test":`,
+			equal: false,
+		},
+	}
+
+	for _, tc := range cases {
+		if got := jsonOrYamlStringsEqual(tc.s1, tc.s2); got != tc.equal {
+			t.Errorf("Expected jsonOrYamlStringsEqual to return %t for %q == %q", tc.equal, tc.s1, tc.s2)
+		}
+	}
+}
@@ -1,10 +1,12 @@
 package aws
 
 import (
+	"encoding/base64"
 	"fmt"
 	"net"
 	"net/url"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -282,6 +284,39 @@ func validateCloudWatchEventRuleName(v interface{}, k string) (ws []string, erro
 	return
 }
 
+var (
+	cloudWatchEventRateExpressionRegexp = regexp.MustCompile(`^rate\((\d+) (minutes?|hours?|days?)\)$`)
+	cloudWatchEventCronExpressionRegexp = regexp.MustCompile(`^cron\(([^ ()]+ ){5}[^ ()]+\)$`)
+)
+
+func validateCloudWatchEventScheduleExpression(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if len(value) > 256 {
+		errors = append(errors, fmt.Errorf(
+			"%q cannot be longer than 256 characters: %q", k, value))
+	}
+
+	// http://docs.aws.amazon.com/AmazonCloudWatch/latest/events/ScheduledEvents.html
+	if m := cloudWatchEventRateExpressionRegexp.FindStringSubmatch(value); m != nil {
+		rate, err := strconv.Atoi(m[1])
+		if err != nil || rate < 1 {
+			errors = append(errors, fmt.Errorf(
+				"%q must have a positive rate value: %q", k, value))
+		} else if singular := !strings.HasSuffix(m[2], "s"); singular != (rate == 1) {
+			errors = append(errors, fmt.Errorf(
+				"%q must use a singular unit for a rate of 1 and a plural unit otherwise: %q", k, value))
+		}
+		return
+	}
+
+	if !cloudWatchEventCronExpressionRegexp.MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q must be a rate(value unit) or cron(fields) expression with six fields: %q", k, value))
+	}
+
+	return
+}
+
 func validateCloudWatchLogResourcePolicyDocument(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 	// http://docs.aws.amazon.com/AmazonCloudWatchLogs/latest/APIReference/API_PutResourcePolicy.html
@@ -557,6 +592,23 @@ func validateLogGroupNamePrefix(v interface{}, k string) (ws []string, errors []
 	return
 }
 
+func validateCloudWatchLogRetentionInDays(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(int)
+
+	// https://docs.aws.amazon.com/AmazonCloudWatchLogs/latest/APIReference/API_PutRetentionPolicy.html
+	// 0 removes the retention policy, so log events never expire.
+	validValues := []int{0, 1, 3, 5, 7, 14, 30, 60, 90, 120, 150, 180, 365, 400, 545, 731, 1096, 1827, 2192, 2557, 2922, 3288, 3653}
+	for _, valid := range validValues {
+		if value == valid {
+			return
+		}
+	}
+
+	errors = append(errors, fmt.Errorf(
+		"%q must be one of %v, got: %d", k, validValues, value))
+	return
+}
+
 func validateS3BucketLifecycleTimestamp(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 	_, err := time.Parse(time.RFC3339, fmt.Sprintf("%sT00:00:00Z", value))
@@ -1376,6 +1428,33 @@ func validateAwsKmsName(v interface{}, k string) (ws []string, es []error) {
 	return
 }
 
+func validateKmsKey(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if value == "" {
+		return
+	}
+
+	if !isKmsAlias(value) && !kmsKeyArnRegexp.MatchString(value) &&
+		!regexp.MustCompile(`^[a-zA-Z0-9-]+$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q must be a KMS key ID, key ARN, alias name or alias ARN: %q", k, value))
+	}
+
+	return
+}
+
+func validateAwsKmsGrantName(v interface{}, k string) (ws []string, es []error) {
+	value := v.(string)
+	if len(value) > 256 {
+		es = append(es, fmt.Errorf("%q can not be greater than 256 characters", k))
+	}
+	if !regexp.MustCompile(`^[a-zA-Z0-9:/_-]+$`).MatchString(value) {
+		es = append(es, fmt.Errorf("%q must be comprised of only [a-zA-Z0-9:/_-]", k))
+	}
+	return
+}
+
 func validateCognitoIdentityPoolName(v interface{}, k string) (ws []string, errors []error) {
 	val := v.(string)
 	if !regexp.MustCompile("^[\\w _]+$").MatchString(val) {
@@ -1710,6 +1789,29 @@ func validateCognitoUserPoolReplyEmailAddress(v interface{}, k string) (ws []str
 	return
 }
 
+func validateCognitoUserPoolDomain(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if !regexp.MustCompile(`^[a-z0-9](?:[a-z0-9\-]{0,61}[a-z0-9])?$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q must be 1 to 63 lowercase alphanumeric characters or hyphens, and cannot begin or end with a hyphen: %q", k, value))
+	}
+	return
+}
+
+func validateCognitoResourceServerScopeName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if len(value) < 1 || len(value) > 256 {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 256 characters long", k))
+	}
+	if !regexp.MustCompile(`^[\x21\x23-\x2E\x30-\x5B\x5D-\x7E]+$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q must contain only printable ASCII characters other than space, \", / and \\: %q", k, value))
+	}
+	return
+}
+
 func validateCognitoUserPoolSchemaName(v interface{}, k string) (ws []string, es []error) {
 	value := v.(string)
 	if len(value) < 1 {
@@ -1997,3 +2099,43 @@ func validateAwsElastiCacheReplicationGroupAuthToken(v interface{}, k string) (w
 	}
 	return
 }
+
+func validateLaunchTemplateName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if len(value) < 3 {
+		errors = append(errors, fmt.Errorf("%q cannot be less than 3 characters", k))
+	} else if len(value) > 125 {
+		errors = append(errors, fmt.Errorf("%q cannot be longer than 125 characters", k))
+	}
+	if !regexp.MustCompile(`^[a-zA-Z0-9\(\)\.\-/_]+$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q can only contain alphanumeric characters and ()./_- symbols", k))
+	}
+	return
+}
+
+func validateLaunchTemplateNamePrefix(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if len(value) > 99 {
+		errors = append(errors, fmt.Errorf("%q cannot be longer than 99 characters, name is limited to 125", k))
+	}
+	if !regexp.MustCompile(`^[a-zA-Z0-9\(\)\.\-/_]+$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q can only contain alphanumeric characters and ()./_- symbols", k))
+	}
+	return
+}
+
+func validateRFC3339TimeString(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := time.Parse(time.RFC3339, v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q: invalid RFC3339 timestamp", k))
+	}
+	return
+}
+
+func validateBase64EncodedString(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := base64.StdEncoding.DecodeString(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q: must be base64-encoded", k))
+	}
+	return
+}
@@ -103,6 +103,44 @@ func TestValidateCloudWatchEventRuleName(t *testing.T) {
 	}
 }
 
+func TestValidateCloudWatchEventScheduleExpression(t *testing.T) {
+	validExpressions := []string{
+		"rate(1 minute)",
+		"rate(5 minutes)",
+		"rate(1 hour)",
+		"rate(12 hours)",
+		"rate(7 days)",
+		"cron(0 10 * * ? *)",
+		"cron(15 12 * * ? *)",
+		"cron(0/15 * ? * MON-FRI *)",
+		"cron(0 8 1 * ? *)",
+	}
+	for _, v := range validExpressions {
+		_, errors := validateCloudWatchEventScheduleExpression(v, "schedule_expression")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid CW event schedule expression: %q", v, errors)
+		}
+	}
+
+	invalidExpressions := []string{
+		"",
+		"rate(1 minutes)",
+		"rate(5 minute)",
+		"rate(0 minutes)",
+		"rate(5 weeks)",
+		"rate(five minutes)",
+		"cron(0 10 * * ?)",
+		"cron(0 10 * * ? * *)",
+		"0 10 * * ? *",
+	}
+	for _, v := range invalidExpressions {
+		_, errors := validateCloudWatchEventScheduleExpression(v, "schedule_expression")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid CW event schedule expression", v)
+		}
+	}
+}
+
 func TestValidateLambdaFunctionName(t *testing.T) {
 	validNames := []string{
 		"arn:aws:lambda:us-west-2:123456789012:function:ThumbNail",
@@ -497,6 +535,24 @@ func TestValidateLogGroupNamePrefix(t *testing.T) {
 	}
 }
 
+func TestValidateCloudWatchLogRetentionInDays(t *testing.T) {
+	validValues := []int{0, 1, 3, 5, 7, 14, 30, 60, 90, 120, 150, 180, 365, 400, 545, 731, 1096, 1827, 2192, 2557, 2922, 3288, 3653}
+	for _, v := range validValues {
+		_, errors := validateCloudWatchLogRetentionInDays(v, "retention_in_days")
+		if len(errors) != 0 {
+			t.Fatalf("%d should be a valid retention: %q", v, errors)
+		}
+	}
+
+	invalidValues := []int{-1, 2, 10, 366, 3654}
+	for _, v := range invalidValues {
+		_, errors := validateCloudWatchLogRetentionInDays(v, "retention_in_days")
+		if len(errors) == 0 {
+			t.Fatalf("%d should be an invalid retention", v)
+		}
+	}
+}
+
 func TestValidateS3BucketLifecycleTimestamp(t *testing.T) {
 	validDates := []string{
 		"2016-01-01",
@@ -2155,6 +2211,76 @@ func TestValidateAwsKmsName(t *testing.T) {
 	}
 }
 
+func TestValidateKmsKey(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+			ErrCount: 0,
+		},
+		{
+			Value:    "1234abcd-12ab-34cd-56ef-1234567890ab",
+			ErrCount: 0,
+		},
+		{
+			Value:    "alias/tf-acc-test",
+			ErrCount: 0,
+		},
+		{
+			Value:    "arn:aws:kms:us-west-2:123456789012:alias/tf-acc-test",
+			ErrCount: 0,
+		},
+		{
+			Value:    "arn:aws:s3:::tf-acc-test",
+			ErrCount: 1,
+		},
+		{
+			Value:    "tf acc test",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateKmsKey(tc.Value, "kms_key_id")
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d errors validating %q, got: %v", tc.ErrCount, tc.Value, errors)
+		}
+	}
+}
+
+func TestValidateAwsKmsGrantName(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "tf-acc-grant",
+			ErrCount: 0,
+		},
+		{
+			Value:    "arn:aws/grant_1",
+			ErrCount: 0,
+		},
+		{
+			Value:    "invalid grant",
+			ErrCount: 1,
+		},
+		{
+			Value:    strings.Repeat("W", 257),
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateAwsKmsGrantName(tc.Value, "name")
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("AWS KMS Grant Name validation failed for %q: %v", tc.Value, errors)
+		}
+	}
+}
+
 func TestValidateCognitoIdentityPoolName(t *testing.T) {
 	validValues := []string{
 		"123",
@@ -2839,3 +2965,113 @@ func TestResourceAWSElastiCacheReplicationGroupAuthTokenValidation(t *testing.T)
 		}
 	}
 }
+
+func TestValidateLaunchTemplateName(t *testing.T) {
+	validNames := []string{
+		"fooBAR123",
+		"foo-bar_baz.(v1)/2",
+	}
+	for _, v := range validNames {
+		_, errors := validateLaunchTemplateName(v, "name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Launch Template name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"ab",
+		"foo bar",
+		"foo*bar",
+		strings.Repeat("W", 126),
+	}
+	for _, v := range invalidNames {
+		_, errors := validateLaunchTemplateName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Launch Template name", v)
+		}
+	}
+}
+
+func TestValidateRFC3339TimeString(t *testing.T) {
+	validTimes := []string{
+		"2018-03-01T00:00:00Z",
+		"2018-03-01T00:00:00+01:00",
+	}
+	for _, v := range validTimes {
+		_, errors := validateRFC3339TimeString(v, "valid_until")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid RFC3339 timestamp: %q", v, errors)
+		}
+	}
+
+	invalidTimes := []string{
+		"2018-03-01",
+		"03/01/2018 00:00:00",
+	}
+	for _, v := range invalidTimes {
+		_, errors := validateRFC3339TimeString(v, "valid_until")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid RFC3339 timestamp", v)
+		}
+	}
+}
+
+func TestValidateCognitoUserPoolDomain(t *testing.T) {
+	validValues := []string{
+		"auth",
+		"auth-domain",
+		"0auth",
+	}
+
+	for _, s := range validValues {
+		_, errors := validateCognitoUserPoolDomain(s, "domain")
+		if len(errors) > 0 {
+			t.Fatalf("%q should be a valid Cognito User Pool domain: %v", s, errors)
+		}
+	}
+
+	invalidValues := []string{
+		"-auth",
+		"auth-",
+		"Auth",
+		"auth.example.com",
+		"a" + strings.Repeat("b", 63),
+	}
+
+	for _, s := range invalidValues {
+		_, errors := validateCognitoUserPoolDomain(s, "domain")
+		if len(errors) == 0 {
+			t.Fatalf("%q should not be a valid Cognito User Pool domain", s)
+		}
+	}
+}
+
+func TestValidateCognitoResourceServerScopeName(t *testing.T) {
+	validValues := []string{
+		"read",
+		"write:all",
+		"users.read",
+	}
+
+	for _, s := range validValues {
+		_, errors := validateCognitoResourceServerScopeName(s, "scope_name")
+		if len(errors) > 0 {
+			t.Fatalf("%q should be a valid Cognito Resource Server scope name: %v", s, errors)
+		}
+	}
+
+	invalidValues := []string{
+		"",
+		"read write",
+		"users/read",
+		`users\read`,
+		strings.Repeat("a", 257),
+	}
+
+	for _, s := range invalidValues {
+		_, errors := validateCognitoResourceServerScopeName(s, "scope_name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should not be a valid Cognito Resource Server scope name", s)
+		}
+	}
+}
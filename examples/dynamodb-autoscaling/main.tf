@@ -0,0 +1,148 @@
+provider "aws" {
+  region = "${var.aws_region}"
+}
+
+resource "aws_dynamodb_table" "example" {
+  name           = "${var.table_name}"
+  read_capacity  = "${var.min_capacity}"
+  write_capacity = "${var.min_capacity}"
+  hash_key       = "Id"
+
+  attribute {
+    name = "Id"
+    type = "S"
+  }
+
+  lifecycle {
+    ignore_changes = ["read_capacity", "write_capacity"]
+  }
+}
+
+resource "aws_iam_role" "autoscaling" {
+  name = "${var.table_name}-autoscaling"
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "application-autoscaling.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_role_policy" "autoscaling" {
+  name = "${var.table_name}-autoscaling"
+  role = "${aws_iam_role.autoscaling.id}"
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": [
+        "dynamodb:DescribeTable",
+        "dynamodb:UpdateTable"
+      ],
+      "Resource": "${aws_dynamodb_table.example.arn}"
+    },
+    {
+      "Effect": "Allow",
+      "Action": [
+        "cloudwatch:PutMetricAlarm",
+        "cloudwatch:DescribeAlarms",
+        "cloudwatch:DeleteAlarms"
+      ],
+      "Resource": "*"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_appautoscaling_target" "read" {
+  max_capacity       = "${var.max_capacity}"
+  min_capacity       = "${var.min_capacity}"
+  resource_id        = "table/${aws_dynamodb_table.example.name}"
+  role_arn           = "${aws_iam_role.autoscaling.arn}"
+  scalable_dimension = "dynamodb:table:ReadCapacityUnits"
+  service_namespace  = "dynamodb"
+  depends_on         = ["aws_iam_role_policy.autoscaling"]
+}
+
+resource "aws_appautoscaling_policy" "read" {
+  name               = "DynamoDBReadCapacityUtilization:${aws_appautoscaling_target.read.resource_id}"
+  policy_type        = "TargetTrackingScaling"
+  resource_id        = "${aws_appautoscaling_target.read.resource_id}"
+  scalable_dimension = "${aws_appautoscaling_target.read.scalable_dimension}"
+  service_namespace  = "${aws_appautoscaling_target.read.service_namespace}"
+
+  target_tracking_scaling_policy_configuration {
+    predefined_metric_specification {
+      predefined_metric_type = "DynamoDBReadCapacityUtilization"
+    }
+
+    target_value = "${var.target_utilization}"
+  }
+}
+
+resource "aws_appautoscaling_target" "write" {
+  max_capacity       = "${var.max_capacity}"
+  min_capacity       = "${var.min_capacity}"
+  resource_id        = "table/${aws_dynamodb_table.example.name}"
+  role_arn           = "${aws_iam_role.autoscaling.arn}"
+  scalable_dimension = "dynamodb:table:WriteCapacityUnits"
+  service_namespace  = "dynamodb"
+  depends_on         = ["aws_iam_role_policy.autoscaling"]
+}
+
+resource "aws_appautoscaling_policy" "write" {
+  name               = "DynamoDBWriteCapacityUtilization:${aws_appautoscaling_target.write.resource_id}"
+  policy_type        = "TargetTrackingScaling"
+  resource_id        = "${aws_appautoscaling_target.write.resource_id}"
+  scalable_dimension = "${aws_appautoscaling_target.write.scalable_dimension}"
+  service_namespace  = "${aws_appautoscaling_target.write.service_namespace}"
+
+  target_tracking_scaling_policy_configuration {
+    predefined_metric_specification {
+      predefined_metric_type = "DynamoDBWriteCapacityUtilization"
+    }
+
+    target_value = "${var.target_utilization}"
+  }
+}
+
+resource "aws_appautoscaling_scheduled_action" "business_hours" {
+  name               = "${var.table_name}-business-hours"
+  resource_id        = "${aws_appautoscaling_target.write.resource_id}"
+  scalable_dimension = "${aws_appautoscaling_target.write.scalable_dimension}"
+  service_namespace  = "${aws_appautoscaling_target.write.service_namespace}"
+  schedule           = "cron(0 8 ? * MON-FRI *)"
+
+  scalable_target_action {
+    min_capacity = "${var.min_capacity * 4}"
+    max_capacity = "${var.max_capacity}"
+  }
+}
+
+resource "aws_appautoscaling_scheduled_action" "overnight" {
+  name               = "${var.table_name}-overnight"
+  resource_id        = "${aws_appautoscaling_target.write.resource_id}"
+  scalable_dimension = "${aws_appautoscaling_target.write.scalable_dimension}"
+  service_namespace  = "${aws_appautoscaling_target.write.service_namespace}"
+  schedule           = "cron(0 20 ? * MON-FRI *)"
+
+  scalable_target_action {
+    min_capacity = "${var.min_capacity}"
+    max_capacity = "${var.max_capacity}"
+  }
+
+  depends_on = ["aws_appautoscaling_scheduled_action.business_hours"]
+}
@@ -0,0 +1,11 @@
+output "table_name" {
+  value = "${aws_dynamodb_table.example.name}"
+}
+
+output "read_policy_arn" {
+  value = "${aws_appautoscaling_policy.read.arn}"
+}
+
+output "write_policy_arn" {
+  value = "${aws_appautoscaling_policy.write.arn}"
+}
@@ -0,0 +1,24 @@
+variable "aws_region" {
+  description = "The AWS region to create things in."
+  default     = "us-west-2"
+}
+
+variable "table_name" {
+  description = "The name of the DynamoDB table."
+  default     = "tf-example-autoscaling"
+}
+
+variable "min_capacity" {
+  description = "The minimum read and write capacity of the table."
+  default     = 5
+}
+
+variable "max_capacity" {
+  description = "The maximum read and write capacity of the table."
+  default     = 100
+}
+
+variable "target_utilization" {
+  description = "The percentage of consumed to provisioned capacity to maintain."
+  default     = 70
+}